@@ -1,6 +1,10 @@
 package main
 
 import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authentication"
@@ -8,8 +12,10 @@ import (
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/ingestion"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/metrics"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/ratelimit"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/tracing"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/version"
@@ -113,12 +119,26 @@ func main() {
 		}
 	})
 
+	// Create ingestion client
+	ingestionCl := ingestion.NewClient(logger, cfgManager, busServices)
+	// Create ingestion context cancelled on shutdown
+	ingestionCtx, ingestionCancel := context.WithCancel(context.Background())
+	// Initialize ingestion
+	err = ingestionCl.Initialize(ingestionCtx)
+	if err != nil {
+		logger.WithError(err).Fatal(err)
+	}
+	// Create ingestion limits client
+	limitsCl := ratelimit.NewClient(cfgManager)
+	// Add configuration reload hook
+	cfgManager.AddOnChangeHook(limitsCl.Reload)
+
 	// Create authentication service
 	authenticationSvc := authentication.NewService(cfgManager)
 
 	// Create servers
 	svr := server.NewServer(logger, cfgManager, metricsCl, tracingSvc, busServices, authenticationSvc, authoSvc)
-	opaSvr := server.NewOPAPublisherServer(logger, cfgManager, metricsCl, tracingSvc, busServices, authenticationSvc)
+	opaSvr := server.NewOPAPublisherServer(logger, cfgManager, metricsCl, tracingSvc, busServices, authenticationSvc, ingestionCl, limitsCl)
 	intSvr := server.NewInternalServer(logger, cfgManager, metricsCl)
 
 	// Add checker for database
@@ -144,6 +164,38 @@ func main() {
 		logger.WithError(err).Fatal(err)
 	}
 
+	// Channel closed when shutdown is done
+	shutdownDone := make(chan struct{})
+	// Stop servers and flush queued payloads on shutdown
+	go func() {
+		// Wait for a termination signal
+		sigs := make(chan os.Signal, 1)
+		signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+		<-sigs
+
+		logger.Info("Shutdown requested, stopping servers")
+		// Create shutdown context in order to not wait active requests forever
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second) //nolint:gomnd // Won't do a const for that
+		defer cancel()
+		// Stop servers first in order to not accept payloads that wouldn't be ingested
+		for _, shutdown := range []func(context.Context) error{opaSvr.Shutdown, svr.Shutdown, intSvr.Shutdown} {
+			// Shutdown server
+			err := shutdown(ctx)
+			// Check error
+			if err != nil {
+				logger.WithError(err).Error(err)
+			}
+		}
+
+		logger.Info("Flushing queued payloads")
+		// Stop ingestion workers
+		ingestionCancel()
+		// Wait for flush
+		ingestionCl.Wait()
+
+		close(shutdownDone)
+	}()
+
 	var g errgroup.Group
 
 	g.Go(svr.Listen)
@@ -153,4 +205,7 @@ func main() {
 	if err := g.Wait(); err != nil {
 		logger.WithError(err).Fatal(err)
 	}
+
+	// Servers are stopped only on shutdown, wait for the end of flush
+	<-shutdownDone
 }
@@ -3,6 +3,7 @@ package authorization
 import (
 	"context"
 
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
 )
 
@@ -12,6 +13,10 @@ type Service interface {
 	IsAuthorized(ctx context.Context, action, resource string) (bool, error)
 	// Check authorized and fail if not authorized
 	CheckAuthorized(ctx context.Context, action, resource string) error
+	// Check if it is authorized with structured resource sent to OPA
+	IsAuthorizedOnResource(ctx context.Context, action, resource string, resourceObj *models.Resource) (bool, error)
+	// Check authorized with structured resource sent to OPA and fail if not authorized
+	CheckAuthorizedOnResource(ctx context.Context, action, resource string, resourceObj *models.Resource) error
 }
 
 func NewService(cfgManager config.Manager) Service {
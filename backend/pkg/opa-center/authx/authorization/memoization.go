@@ -0,0 +1,55 @@
+package authorization
+
+import (
+	"context"
+	"sync"
+)
+
+// contextKey is a value for use with context.WithValue.
+type contextKey struct {
+	name string
+}
+
+var memoizationContextKey = &contextKey{name: "AUTHORIZATION_MEMOIZATION_CONTEXT_KEY"}
+
+// memoization stores authorization results of a request.
+// User is the same for the whole request, so only action and resource are used as key.
+// Resource contains the structured resource when it is set.
+type memoization struct {
+	mutex   sync.RWMutex
+	results map[string]bool
+}
+
+// SetMemoizationToContext will enable memoization of authorization results
+// for all calls using the returned context.
+// This must be used with a request scoped context only.
+func SetMemoizationToContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, memoizationContextKey, &memoization{results: map[string]bool{}})
+}
+
+// getMemoizationFromContext will return memoization from context if it exists.
+func getMemoizationFromContext(ctx context.Context) *memoization {
+	res, _ := ctx.Value(memoizationContextKey).(*memoization)
+
+	return res
+}
+
+func (m *memoization) get(action, resource string) (bool, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	res, ok := m.results[memoizationKey(action, resource)]
+
+	return res, ok
+}
+
+func (m *memoization) set(action, resource string, authorized bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.results[memoizationKey(action, resource)] = authorized
+}
+
+func memoizationKey(action, resource string) string {
+	return action + "\x00" + resource
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	gin "github.com/gin-gonic/gin"
 	gomock "github.com/golang/mock/gomock"
+	models "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
 	reflect "reflect"
 )
 
@@ -48,6 +49,20 @@ func (mr *MockServiceMockRecorder) CheckAuthorized(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAuthorized", reflect.TypeOf((*MockService)(nil).CheckAuthorized), arg0, arg1, arg2)
 }
 
+// CheckAuthorizedOnResource mocks base method
+func (m *MockService) CheckAuthorizedOnResource(arg0 context.Context, arg1, arg2 string, arg3 *models.Resource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAuthorizedOnResource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAuthorizedOnResource indicates an expected call of CheckAuthorizedOnResource
+func (mr *MockServiceMockRecorder) CheckAuthorizedOnResource(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAuthorizedOnResource", reflect.TypeOf((*MockService)(nil).CheckAuthorizedOnResource), arg0, arg1, arg2, arg3)
+}
+
 // IsAuthorized mocks base method
 func (m *MockService) IsAuthorized(arg0 context.Context, arg1, arg2 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +78,21 @@ func (mr *MockServiceMockRecorder) IsAuthorized(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAuthorized", reflect.TypeOf((*MockService)(nil).IsAuthorized), arg0, arg1, arg2)
 }
 
+// IsAuthorizedOnResource mocks base method
+func (m *MockService) IsAuthorizedOnResource(arg0 context.Context, arg1, arg2 string, arg3 *models.Resource) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAuthorizedOnResource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAuthorizedOnResource indicates an expected call of IsAuthorizedOnResource
+func (mr *MockServiceMockRecorder) IsAuthorizedOnResource(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAuthorizedOnResource", reflect.TypeOf((*MockService)(nil).IsAuthorizedOnResource), arg0, arg1, arg2, arg3)
+}
+
 // Middleware mocks base method
 func (m *MockService) Middleware() gin.HandlerFunc {
 	m.ctrl.T.Helper()
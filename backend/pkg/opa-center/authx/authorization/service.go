@@ -29,8 +29,10 @@ type generalInputDataOPA struct {
 }
 
 type generalDataOPA struct {
-	Action   string `json:"action"`
-	Resource string `json:"resource"`
+	Action string `json:"action"`
+	// Resource string is kept in order to keep existing policies working
+	Resource       string           `json:"resource"`
+	ResourceObject *models.Resource `json:"resource_object,omitempty"`
 }
 
 type opaAnswer struct {
@@ -38,6 +40,14 @@ type opaAnswer struct {
 }
 
 func (s *service) IsAuthorized(ctx context.Context, action, resource string) (bool, error) {
+	return s.IsAuthorizedOnResource(ctx, action, resource, nil)
+}
+
+func (s *service) IsAuthorizedOnResource(
+	ctx context.Context,
+	action, resource string,
+	resourceObj *models.Resource,
+) (bool, error) {
 	// Get logger
 	logger := log.GetLoggerFromContext(ctx)
 	// Get configuration to check that authorization can be calculated
@@ -47,6 +57,32 @@ func (s *service) IsAuthorized(ctx context.Context, action, resource string) (bo
 		return true, nil
 	}
 
+	// Get memoization from context
+	memo := getMemoizationFromContext(ctx)
+	// Build memoization resource key
+	memoResource := resource
+	// Check if structured resource is set
+	// Resource string can be the same for different structured resources (example: list actions)
+	if memo != nil && resourceObj != nil {
+		// Json encode structured resource
+		bb, err := json.Marshal(resourceObj)
+		// Check error
+		if err != nil {
+			return false, err
+		}
+
+		memoResource = resource + "\x00" + string(bb)
+	}
+	// Check if result is already known for this request
+	if memo != nil {
+		// Get memoized result
+		authorized, ok := memo.get(action, memoResource)
+		// Check if result exists
+		if ok {
+			return authorized, nil
+		}
+	}
+
 	// Get user from context
 	user := authentication.GetAuthenticatedUserFromContext(ctx)
 
@@ -56,8 +92,9 @@ func (s *service) IsAuthorized(ctx context.Context, action, resource string) (bo
 			User: user,
 			Tags: cfg.Tags,
 			Data: &generalDataOPA{
-				Action:   action,
-				Resource: resource,
+				Action:         action,
+				Resource:       resource,
+				ResourceObject: resourceObj,
 			},
 		},
 	}
@@ -73,6 +110,11 @@ func (s *service) IsAuthorized(ctx context.Context, action, resource string) (bo
 		return false, err
 	}
 
+	// Memoize result for next calls in this request
+	if memo != nil {
+		memo.set(action, memoResource, authorized)
+	}
+
 	// Check if user isn't authorized
 	if !authorized {
 		logger.Infof("User %s not authorized for action %s on resource %s", user.GetIdentifier(), action, resource)
@@ -121,8 +163,16 @@ func (s *service) requestOPAServer(ctx context.Context, opaCfg *config.OPAServer
 }
 
 func (s *service) CheckAuthorized(ctx context.Context, action, resource string) error {
+	return s.CheckAuthorizedOnResource(ctx, action, resource, nil)
+}
+
+func (s *service) CheckAuthorizedOnResource(
+	ctx context.Context,
+	action, resource string,
+	resourceObj *models.Resource,
+) error {
 	// Call is authorized
-	res, err := s.IsAuthorized(ctx, action, resource)
+	res, err := s.IsAuthorizedOnResource(ctx, action, resource, resourceObj)
 	// Check error
 	if err != nil {
 		return err
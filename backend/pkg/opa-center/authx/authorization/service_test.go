@@ -0,0 +1,300 @@
+//+build unit
+
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/opentracing/opentracing-go"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authentication"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
+	cmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/config/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// opaStub is a fake OPA server storing received bodies.
+type opaStub struct {
+	server *httptest.Server
+	mutex  sync.Mutex
+	bodies []string
+	result bool
+}
+
+// newOPAStub will start a fake OPA server answering result.
+func newOPAStub(t *testing.T, result bool) *opaStub {
+	stub := &opaStub{result: result}
+	stub.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		bb, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		stub.mutex.Lock()
+		stub.bodies = append(stub.bodies, string(bb))
+		stub.mutex.Unlock()
+
+		_ = json.NewEncoder(w).Encode(map[string]bool{"result": stub.result})
+	}))
+
+	return stub
+}
+
+// newTestService will create a service using a fake OPA server.
+func newTestService(ctrl *gomock.Controller, url string) *service {
+	cfgManagerMock := cmocks.NewMockManager(ctrl)
+	cfgManagerMock.EXPECT().GetConfig().Return(&config.Config{
+		OPAServerAuthorization: &config.OPAServerAuthorization{URL: url, Tags: map[string]string{"env": "test"}},
+	}).AnyTimes()
+
+	return &service{cfgManager: cfgManagerMock}
+}
+
+// newTestContext will create a context with logger, trace and user.
+func newTestContext() context.Context {
+	ctx := log.SetLoggerToContext(context.TODO(), log.NewLogger())
+	ctx = opentracing.ContextWithSpan(ctx, opentracing.NoopTracer{}.StartSpan("test"))
+
+	return authentication.SetAuthenticatedUserToContext(ctx, &models.OIDCUser{PreferredUsername: "user1"})
+}
+
+func Test_service_IsAuthorizedOnResource_input(t *testing.T) {
+	partitionResource := &models.Resource{
+		Type: "partitions",
+		ID:   "p1",
+		Name: "fake",
+		Attributes: map[string]interface{}{
+			"labels": map[string]string{"team": "a"},
+			"owners": []string{"owner@example.com"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		resource    string
+		resourceObj *models.Resource
+		wantInput   string
+	}{
+		{
+			name:     "without structured resource",
+			resource: "partitions:fake",
+			wantInput: `{"input": {
+				"user": {
+					"preferred_username": "user1", "name": "", "given_name": "", "family_name": "",
+					"email": "", "email_verified": false
+				},
+				"tags": {"env": "test"},
+				"data": {"action": "partitions:Update", "resource": "partitions:fake"}
+			}}`,
+		},
+		{
+			name:        "with structured resource",
+			resource:    "partitions:fake",
+			resourceObj: partitionResource,
+			wantInput: `{"input": {
+				"user": {
+					"preferred_username": "user1", "name": "", "given_name": "", "family_name": "",
+					"email": "", "email_verified": false
+				},
+				"tags": {"env": "test"},
+				"data": {
+					"action": "partitions:Update",
+					"resource": "partitions:fake",
+					"resource_object": {
+						"type": "partitions",
+						"id": "p1",
+						"name": "fake",
+						"attributes": {"labels": {"team": "a"}, "owners": ["owner@example.com"]}
+					}
+				}
+			}}`,
+		},
+		{
+			name:        "with structured resource of a missing object",
+			resource:    "partitions:p1",
+			resourceObj: &models.Resource{Type: "partitions", ID: "p1"},
+			wantInput: `{"input": {
+				"user": {
+					"preferred_username": "user1", "name": "", "given_name": "", "family_name": "",
+					"email": "", "email_verified": false
+				},
+				"tags": {"env": "test"},
+				"data": {
+					"action": "partitions:Update",
+					"resource": "partitions:p1",
+					"resource_object": {"type": "partitions", "id": "p1"}
+				}
+			}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stub := newOPAStub(t, true)
+			defer stub.server.Close()
+
+			s := newTestService(ctrl, stub.server.URL)
+
+			got, err := s.IsAuthorizedOnResource(newTestContext(), "partitions:Update", tt.resource, tt.resourceObj)
+			assert.NoError(t, err)
+			assert.True(t, got)
+			if assert.Len(t, stub.bodies, 1) {
+				assert.JSONEq(t, tt.wantInput, stub.bodies[0])
+			}
+		})
+	}
+}
+
+func Test_service_IsAuthorizedOnResource_memoization(t *testing.T) {
+	resource1 := &models.Resource{Type: "partitions", ID: "p1", Name: "fake1"}
+	resource2 := &models.Resource{Type: "partitions", ID: "p2", Name: "fake2"}
+
+	type call struct {
+		action      string
+		resource    string
+		resourceObj *models.Resource
+	}
+
+	tests := []struct {
+		name         string
+		memoization  bool
+		calls        []call
+		wantRequests int
+	}{
+		{
+			name:        "same resource string and structured resource is a hit",
+			memoization: true,
+			calls: []call{
+				{action: "partitions:FindByID", resource: "partitions", resourceObj: resource1},
+				{action: "partitions:FindByID", resource: "partitions", resourceObj: resource1},
+			},
+			wantRequests: 1,
+		},
+		{
+			name:        "same resource string and different structured resource is a miss",
+			memoization: true,
+			calls: []call{
+				{action: "partitions:FindByID", resource: "partitions", resourceObj: resource1},
+				{action: "partitions:FindByID", resource: "partitions", resourceObj: resource2},
+			},
+			wantRequests: 2,
+		},
+		{
+			name:        "same resource string with and without structured resource is a miss",
+			memoization: true,
+			calls: []call{
+				{action: "partitions:FindByID", resource: "partitions", resourceObj: resource1},
+				{action: "partitions:FindByID", resource: "partitions"},
+			},
+			wantRequests: 2,
+		},
+		{
+			name:        "different action is a miss",
+			memoization: true,
+			calls: []call{
+				{action: "partitions:FindByID", resource: "partitions", resourceObj: resource1},
+				{action: "partitions:Update", resource: "partitions", resourceObj: resource1},
+			},
+			wantRequests: 2,
+		},
+		{
+			name: "without memoization",
+			calls: []call{
+				{action: "partitions:FindByID", resource: "partitions", resourceObj: resource1},
+				{action: "partitions:FindByID", resource: "partitions", resourceObj: resource1},
+			},
+			wantRequests: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stub := newOPAStub(t, true)
+			defer stub.server.Close()
+
+			s := newTestService(ctrl, stub.server.URL)
+
+			ctx := newTestContext()
+			if tt.memoization {
+				ctx = SetMemoizationToContext(ctx)
+			}
+
+			for _, c := range tt.calls {
+				got, err := s.IsAuthorizedOnResource(ctx, c.action, c.resource, c.resourceObj)
+				assert.NoError(t, err)
+				assert.True(t, got)
+			}
+
+			assert.Len(t, stub.bodies, tt.wantRequests)
+		})
+	}
+}
+
+func Test_service_CheckAuthorizedOnResource(t *testing.T) {
+	tests := []struct {
+		name       string
+		result     bool
+		configured bool
+		wantErr    bool
+	}{
+		{
+			name:       "authorized",
+			result:     true,
+			configured: true,
+		},
+		{
+			name:       "forbidden",
+			configured: true,
+			wantErr:    true,
+		},
+		{
+			name: "authorized without OPA server configuration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stub := newOPAStub(t, tt.result)
+			defer stub.server.Close()
+
+			s := newTestService(ctrl, stub.server.URL)
+			if !tt.configured {
+				cfgManagerMock := cmocks.NewMockManager(ctrl)
+				cfgManagerMock.EXPECT().GetConfig().Return(&config.Config{})
+				s = &service{cfgManager: cfgManagerMock}
+			}
+
+			err := s.CheckAuthorizedOnResource(newTestContext(), "partitions:Update", "partitions:fake", nil)
+			if tt.wantErr {
+				// nolint: errorlint // Ignore this because the aim is to catch project error at first level
+				err2, ok := err.(errors.Error)
+				if assert.True(t, ok) {
+					assert.Equal(t, http.StatusForbidden, err2.StatusCode())
+				}
+
+				return
+			}
+
+			assert.NoError(t, err)
+			if !tt.configured {
+				assert.Empty(t, stub.bodies)
+			}
+		})
+	}
+}
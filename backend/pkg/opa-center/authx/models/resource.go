@@ -0,0 +1,14 @@
+package models
+
+// Resource is the structured representation of the resource on which an action is performed.
+// It is sent to OPA servers in addition to the resource string.
+type Resource struct {
+	// Resource type (example: partitions)
+	Type string `json:"type"`
+	// Resource id (empty for list actions or when resource doesn't exist yet)
+	ID string `json:"id,omitempty"`
+	// Resource name when resource have one
+	Name string `json:"name,omitempty"`
+	// Resource attributes (example: partition labels or partition of a decision log)
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
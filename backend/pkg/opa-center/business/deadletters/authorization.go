@@ -0,0 +1,38 @@
+package deadletters
+
+import (
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+)
+
+// getAuthorizationResource will return resource sent to OPA for a dead letter.
+// Only type and id are known when dead letter doesn't exist.
+func getAuthorizationResource(id string, dl *models.DeadLetter, partition *pmodels.Partition) *authxmodels.Resource {
+	// Create resource
+	res := &authxmodels.Resource{Type: mainAuthorizationPrefix, ID: id}
+	// Check if dead letter exists
+	if dl == nil {
+		return res
+	}
+
+	// Store attributes
+	res.Attributes = map[string]interface{}{
+		"kind":      dl.Kind.String(),
+		"partition": pmodels.NewAuthorizationResource(dl.PartitionID, partition),
+	}
+
+	return res
+}
+
+// addAuthorizationProjection will add fields needed for authorization in projection.
+func addAuthorizationProjection(projection *models.Projection) {
+	// Check if a projection is used
+	if projection == nil {
+		return
+	}
+
+	projection.ID = true
+	projection.Kind = true
+	projection.PartitionID = true
+}
@@ -0,0 +1,75 @@
+package deadletters
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authorization"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/daos"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	dlmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+)
+
+//go:generate mockgen -destination=./mocks/mock_Service.go -package=mocks github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters Service
+type Service interface {
+	// Migrate database
+	MigrateDB(systemLogger log.Logger) error
+	// Create dead letter used internally only
+	// The same payload rejected multiple times will be stored only once
+	UnsecureCreate(kind models.KindEnum, partitionID, reason string, body []byte) error
+	// Manage retention data of a kind of dead letters
+	ManageRetention(logger log.Logger, retentionDuration time.Duration, partitionID string, kind models.KindEnum) error
+	// Remove permanently all data of a partition in batches
+	PurgePartition(logger log.Logger, partitionID string) error
+	// Get data paginated across all partitions that caller is authorized to read
+	GetAllPaginated(
+		ctx context.Context,
+		page *pagination.PageInput,
+		sort *models.SortOrder,
+		filter *models.Filter,
+		projection *models.Projection,
+	) ([]*models.DeadLetter, *pagination.PageOutput, error)
+	// Find by id
+	FindByID(ctx context.Context, id string, projection *models.Projection) (*models.DeadLetter, error)
+	// Replay dead letter payload
+	Replay(ctx context.Context, inp *models.ReplayInput) (*models.DeadLetter, error)
+}
+
+//go:generate mockgen -destination=./mocks/mock_PartitionService.go -package=mocks github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters PartitionService
+type PartitionService interface {
+	UnsecureFindByID(id string) (*pmodels.Partition, error)
+	FindAuthorizedIDs(ctx context.Context, id, name *string) ([]string, error)
+}
+
+type DecisionLogsService interface {
+	UnsecureCreate(partitionID string, inp []map[string]interface{}) (*dlmodels.IngestionResult, error)
+}
+
+type StatusesService interface {
+	UnsecureCreate(partitionID string, inp map[string]interface{}) error
+}
+
+func NewService(
+	db database.DB,
+	authoSvc authorization.Service,
+	partitionSvc PartitionService,
+	decisionLogsSvc DecisionLogsService,
+	statusesSvc StatusesService,
+) Service {
+	// Create dao
+	dao := daos.NewDao(db)
+
+	return &service{
+		dao:              dao,
+		validator:        validator.New(),
+		authorizationSvc: authoSvc,
+		partitionSvc:     partitionSvc,
+		decisionLogsSvc:  decisionLogsSvc,
+		statusesSvc:      statusesSvc,
+	}
+}
@@ -0,0 +1,43 @@
+package daos
+
+import (
+	"time"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
+)
+
+//go:generate mockgen -destination=./mocks/mock_Dao.go -package=mocks github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/daos Dao
+
+// Dao represent a dead letters access object service.
+type Dao interface {
+	// MigrateDB will migrate database
+	MigrateDB() error
+	// CreateIfNotExists will insert object in database and ignore it if the checksum already exists
+	CreateIfNotExists(ins *models.DeadLetter) error
+	// MarkReplayed will set replay date only if dead letter hasn't been replayed yet
+	// Result is false when dead letter doesn't exist or has already been replayed
+	MarkReplayed(id string, replayedAt time.Time) (bool, error)
+	// UnmarkReplayed will remove replay date only if it is the given one
+	UnmarkReplayed(id string, replayedAt time.Time) error
+	// Delete will remove permanently dead letters matching filter
+	Delete(filter *models.Filter) error
+	// Delete permanently at most limit elements of a partition and return the number of deleted elements
+	DeleteBatchByPartitionID(partitionID string, limit int) (int64, error)
+	// FindByID will find by id
+	FindByID(id string, projection *models.Projection) (*models.DeadLetter, error)
+	// Get data paginated
+	GetAllPaginated(
+		page *pagination.PageInput,
+		sort *models.SortOrder,
+		filter *models.Filter,
+		projection *models.Projection,
+	) ([]*models.DeadLetter, *pagination.PageOutput, error)
+}
+
+func NewDao(db database.DB) Dao {
+	return &service{
+		db: db,
+	}
+}
@@ -0,0 +1,37 @@
+package daos
+
+import (
+	daomodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/daos/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+)
+
+func toDao(ins *models.DeadLetter) *daomodels.DeadLetter {
+	val := &daomodels.DeadLetter{
+		Kind:        ins.Kind.String(),
+		PartitionID: ins.PartitionID,
+		Reason:      ins.Reason,
+		Body:        ins.Body,
+		Checksum:    ins.Checksum,
+		ReplayedAt:  ins.ReplayedAt,
+	}
+	// Add other data
+	val.ID = ins.ID
+	val.CreatedAt = ins.CreatedAt
+	val.UpdatedAt = ins.UpdatedAt
+
+	return val
+}
+
+func fromDao(ins *daomodels.DeadLetter) *models.DeadLetter {
+	return &models.DeadLetter{
+		ID:          ins.ID,
+		CreatedAt:   ins.CreatedAt,
+		UpdatedAt:   ins.UpdatedAt,
+		Kind:        models.KindEnum(ins.Kind),
+		PartitionID: ins.PartitionID,
+		Reason:      ins.Reason,
+		Body:        ins.Body,
+		Checksum:    ins.Checksum,
+		ReplayedAt:  ins.ReplayedAt,
+	}
+}
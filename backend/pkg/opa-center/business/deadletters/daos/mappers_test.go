@@ -0,0 +1,116 @@
+//+build unit
+
+package daos
+
+import (
+	"testing"
+	"time"
+
+	daomodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/daos/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toDao(t *testing.T) {
+	now := time.Now()
+
+	type args struct {
+		ins *models.DeadLetter
+	}
+	tests := []struct {
+		name string
+		args args
+		want *daomodels.DeadLetter
+	}{
+		{
+			name: "empty input",
+			args: args{
+				ins: &models.DeadLetter{},
+			},
+			want: &daomodels.DeadLetter{},
+		},
+		{
+			name: "mapper",
+			args: args{
+				ins: &models.DeadLetter{
+					CreatedAt:   now,
+					UpdatedAt:   now,
+					ID:          "fake id",
+					Kind:        models.KindEnumDecisionLogs,
+					PartitionID: "fake pid",
+					Reason:      "fake reason",
+					Body:        `[{"decision_id":1}]`,
+					Checksum:    "fake checksum",
+					ReplayedAt:  &now,
+				},
+			},
+			want: &daomodels.DeadLetter{
+				Base: database.Base{
+					CreatedAt: now,
+					UpdatedAt: now,
+					ID:        "fake id",
+				},
+				Kind:        "DECISION_LOGS",
+				PartitionID: "fake pid",
+				Reason:      "fake reason",
+				Body:        `[{"decision_id":1}]`,
+				Checksum:    "fake checksum",
+				ReplayedAt:  &now,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := toDao(tt.args.ins)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_fromDao(t *testing.T) {
+	now := time.Now()
+
+	type args struct {
+		ins *daomodels.DeadLetter
+	}
+	tests := []struct {
+		name string
+		args args
+		want *models.DeadLetter
+	}{
+		{
+			name: "mapper",
+			args: args{
+				ins: &daomodels.DeadLetter{
+					Base: database.Base{
+						ID:        "fake id",
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+					Kind:        "STATUS",
+					PartitionID: "fake pid",
+					Reason:      "fake reason",
+					Body:        `{"key1":"val1"`,
+					Checksum:    "fake checksum",
+				},
+			},
+			want: &models.DeadLetter{
+				CreatedAt:   now,
+				UpdatedAt:   now,
+				ID:          "fake id",
+				Kind:        models.KindEnumStatus,
+				PartitionID: "fake pid",
+				Reason:      "fake reason",
+				Body:        `{"key1":"val1"`,
+				Checksum:    "fake checksum",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fromDao(tt.args.ins)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
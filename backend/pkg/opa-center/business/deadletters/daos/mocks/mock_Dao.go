@@ -0,0 +1,153 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/daos (interfaces: Dao)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	pagination "github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
+	reflect "reflect"
+	time "time"
+)
+
+// MockDao is a mock of Dao interface
+type MockDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockDaoMockRecorder
+}
+
+// MockDaoMockRecorder is the mock recorder for MockDao
+type MockDaoMockRecorder struct {
+	mock *MockDao
+}
+
+// NewMockDao creates a new mock instance
+func NewMockDao(ctrl *gomock.Controller) *MockDao {
+	mock := &MockDao{ctrl: ctrl}
+	mock.recorder = &MockDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDao) EXPECT() *MockDaoMockRecorder {
+	return m.recorder
+}
+
+// CreateIfNotExists mocks base method
+func (m *MockDao) CreateIfNotExists(arg0 *models.DeadLetter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIfNotExists", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIfNotExists indicates an expected call of CreateIfNotExists
+func (mr *MockDaoMockRecorder) CreateIfNotExists(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockDao)(nil).CreateIfNotExists), arg0)
+}
+
+// Delete mocks base method
+func (m *MockDao) Delete(arg0 *models.Filter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockDaoMockRecorder) Delete(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDao)(nil).Delete), arg0)
+}
+
+// DeleteBatchByPartitionID mocks base method
+func (m *MockDao) DeleteBatchByPartitionID(arg0 string, arg1 int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBatchByPartitionID", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBatchByPartitionID indicates an expected call of DeleteBatchByPartitionID
+func (mr *MockDaoMockRecorder) DeleteBatchByPartitionID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBatchByPartitionID", reflect.TypeOf((*MockDao)(nil).DeleteBatchByPartitionID), arg0, arg1)
+}
+
+// FindByID mocks base method
+func (m *MockDao) FindByID(arg0 string, arg1 *models.Projection) (*models.DeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.DeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID
+func (mr *MockDaoMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockDao)(nil).FindByID), arg0, arg1)
+}
+
+// GetAllPaginated mocks base method
+func (m *MockDao) GetAllPaginated(arg0 *pagination.PageInput, arg1 *models.SortOrder, arg2 *models.Filter, arg3 *models.Projection) ([]*models.DeadLetter, *pagination.PageOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPaginated", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.DeadLetter)
+	ret1, _ := ret[1].(*pagination.PageOutput)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAllPaginated indicates an expected call of GetAllPaginated
+func (mr *MockDaoMockRecorder) GetAllPaginated(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPaginated", reflect.TypeOf((*MockDao)(nil).GetAllPaginated), arg0, arg1, arg2, arg3)
+}
+
+// MarkReplayed mocks base method
+func (m *MockDao) MarkReplayed(arg0 string, arg1 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReplayed", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkReplayed indicates an expected call of MarkReplayed
+func (mr *MockDaoMockRecorder) MarkReplayed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReplayed", reflect.TypeOf((*MockDao)(nil).MarkReplayed), arg0, arg1)
+}
+
+// MigrateDB mocks base method
+func (m *MockDao) MigrateDB() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateDB")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateDB indicates an expected call of MigrateDB
+func (mr *MockDaoMockRecorder) MigrateDB() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateDB", reflect.TypeOf((*MockDao)(nil).MigrateDB))
+}
+
+// UnmarkReplayed mocks base method
+func (m *MockDao) UnmarkReplayed(arg0 string, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnmarkReplayed", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnmarkReplayed indicates an expected call of UnmarkReplayed
+func (mr *MockDaoMockRecorder) UnmarkReplayed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmarkReplayed", reflect.TypeOf((*MockDao)(nil).UnmarkReplayed), arg0, arg1)
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
+)
+
+// DeadLetter is a rejected payload.
+// Checksum is unique among dead letters not replayed yet in order to store
+// a payload rejected again after its replay as a new dead letter.
+type DeadLetter struct {
+	database.Base
+	Kind        string `gorm:"index"`
+	PartitionID string `gorm:"index"`
+	Reason      string
+	Body        string
+	Checksum    string `gorm:"uniqueIndex:idx_dead_letters_pending_checksum,where:replayed_at IS NULL"`
+	ReplayedAt  *time.Time
+}
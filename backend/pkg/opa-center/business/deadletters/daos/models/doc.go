@@ -0,0 +1,3 @@
+package models
+
+// This package will manage dao models for dead letters data.
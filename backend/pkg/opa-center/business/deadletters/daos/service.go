@@ -0,0 +1,185 @@
+package daos
+
+import (
+	"errors"
+	"time"
+
+	daosmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/daos/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type service struct {
+	db database.DB
+}
+
+// Old unique index on checksum replaced by a unique index on dead letters not replayed.
+const oldChecksumIndexName = "idx_dead_letters_checksum"
+
+func (s *service) MigrateDB() error {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Get migrator
+	migrator := gdb.Migrator()
+	// Check if old unique index exists
+	if migrator.HasIndex(&daosmodels.DeadLetter{}, oldChecksumIndexName) {
+		// Drop it in order to store a payload rejected again after its replay
+		err := migrator.DropIndex(&daosmodels.DeadLetter{}, oldChecksumIndexName)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Migrate
+	err := gdb.AutoMigrate(&daosmodels.DeadLetter{})
+
+	return err
+}
+
+func (s *service) FindByID(id string, projection *models.Projection) (*models.DeadLetter, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Create result
+	var res daosmodels.DeadLetter
+
+	// Manage projection
+	gdb, err := common.ManageProjection(projection, gdb)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Find in db
+	dbres := gdb.Where("id = ?", id).First(&res)
+
+	// check error
+	if dbres.Error != nil {
+		// Check if error is a not found error
+		if errors.Is(dbres.Error, gorm.ErrRecordNotFound) {
+			// Return nil as answer
+			return nil, nil
+		}
+		// Another error
+		return nil, dbres.Error
+	}
+
+	return fromDao(&res), nil
+}
+
+func (s *service) CreateIfNotExists(ins *models.DeadLetter) error {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Transform object
+	input := toDao(ins)
+	// Create
+	// Same payload rejected multiple times (OPA retries) will be ignored while it isn't replayed
+	// Conflict target must match the partial unique index
+	res := gdb.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "checksum"}},
+			Where:     clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "replayed_at IS NULL"}}},
+			DoNothing: true,
+		}).
+		Create(input)
+
+	return res.Error
+}
+
+func (s *service) MarkReplayed(id string, replayedAt time.Time) (bool, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Update only if dead letter hasn't been replayed
+	// This is done in one request in order to avoid concurrent replays
+	res := gdb.
+		Model(&daosmodels.DeadLetter{}).
+		Where("id = ? AND replayed_at IS NULL", id).
+		Update("replayed_at", replayedAt)
+	// Check error
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return res.RowsAffected == 1, nil
+}
+
+func (s *service) UnmarkReplayed(id string, replayedAt time.Time) error {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Remove replay date only if it is the one set by caller
+	return gdb.
+		Model(&daosmodels.DeadLetter{}).
+		Where("id = ? AND replayed_at = ?", id, replayedAt).
+		Update("replayed_at", nil).
+		Error
+}
+
+func (s *service) Delete(filter *models.Filter) error {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Apply filter
+	db, err := common.ManageFilter(filter, gdb)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	return db.Unscoped().Delete(&daosmodels.DeadLetter{}).Error
+}
+
+func (s *service) DeleteBatchByPartitionID(partitionID string, limit int) (int64, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Select ids of batch
+	sub := gdb.Unscoped().
+		Model(&daosmodels.DeadLetter{}).
+		Select("id").
+		Where("partition_id = ?", partitionID).
+		Limit(limit)
+	// Delete batch
+	res := gdb.Unscoped().Where("id IN (?)", sub).Delete(&daosmodels.DeadLetter{})
+	// Check error
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return res.RowsAffected, nil
+}
+
+func (s *service) GetAllPaginated(
+	page *pagination.PageInput,
+	sort *models.SortOrder,
+	filter *models.Filter,
+	projection *models.Projection,
+) ([]*models.DeadLetter, *pagination.PageOutput, error) {
+	// Get gorm db
+	db := s.db.GetGormDB()
+	// result
+	dres := make([]*daosmodels.DeadLetter, 0)
+	// Find dead letters
+	pageOut, err := pagination.Paging(&dres, &pagination.PagingOptions{
+		DB:         db,
+		Filter:     filter,
+		PageInput:  page,
+		Projection: projection,
+		Sort:       sort,
+	})
+	// Check error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Result
+	res := make([]*models.DeadLetter, 0)
+	// Loop over list
+	for i := 0; i < len(dres); i++ {
+		// Map and append
+		res = append(res, fromDao(dres[i]))
+	}
+
+	return res, pageOut, nil
+}
@@ -0,0 +1,73 @@
+//+build unit
+
+package daos
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newMockedService will create a dao service on a mocked database.
+func newMockedService(t *testing.T) (*service, sqlmock.Sqlmock, func()) {
+	ctrl := gomock.NewController(t)
+
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{Logger: logger.Discard})
+	assert.NoError(t, err)
+
+	dbMock := mocks.NewMockDB(ctrl)
+	dbMock.EXPECT().GetGormDB().Return(db).AnyTimes()
+
+	return &service{db: dbMock}, mock, func() {
+		assert.NoError(t, mock.ExpectationsWereMet())
+		ctrl.Finish()
+	}
+}
+
+func Test_service_CreateIfNotExists(t *testing.T) {
+	s, mock, finish := newMockedService(t)
+	defer finish()
+
+	// Conflict must only be on dead letters not replayed
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`INSERT INTO "dead_letters" ("id","created_at","updated_at","deleted_at","kind","partition_id","reason","body","checksum","replayed_at") ` +
+			`VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) ON CONFLICT ("checksum") WHERE replayed_at IS NULL DO NOTHING`,
+	)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := s.CreateIfNotExists(&models.DeadLetter{
+		Kind:        models.KindEnumStatus,
+		PartitionID: "p1",
+		Reason:      "fake",
+		Body:        "{}",
+		Checksum:    "checksum",
+	})
+	assert.NoError(t, err)
+}
+
+func Test_service_DeleteBatchByPartitionID(t *testing.T) {
+	s, mock, finish := newMockedService(t)
+	defer finish()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`DELETE FROM "dead_letters" WHERE id IN (SELECT "id" FROM "dead_letters" WHERE partition_id = $1 LIMIT 10)`,
+	)).WithArgs("p1").WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	got, err := s.DeleteBatchByPartitionID("p1", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), got)
+}
@@ -0,0 +1,3 @@
+package deadletters
+
+// This package will manage rejected payloads that can be replayed.
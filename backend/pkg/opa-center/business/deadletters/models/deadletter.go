@@ -0,0 +1,68 @@
+package models
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// KindEnum represents the kind of payload stored in a dead letter.
+type KindEnum string
+
+var (
+	KindEnumDecisionLogs KindEnum = "DECISION_LOGS"
+	KindEnumStatus       KindEnum = "STATUS"
+)
+
+var AllKindEnum = []KindEnum{
+	KindEnumDecisionLogs,
+	KindEnumStatus,
+}
+
+func (e KindEnum) IsValid() bool {
+	switch e {
+	case KindEnumDecisionLogs, KindEnumStatus:
+		return true
+	}
+
+	return false
+}
+
+func (e KindEnum) String() string {
+	return string(e)
+}
+
+func (e *KindEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = KindEnum(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid KindEnum", str)
+	}
+
+	return nil
+}
+
+func (e KindEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type DeadLetter struct {
+	ID          string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Kind        KindEnum `validate:"required"`
+	PartitionID string   `validate:"required"`
+	Reason      string   `validate:"required"`
+	Body        string
+	Checksum    string `validate:"required"`
+	ReplayedAt  *time.Time
+}
+
+type ReplayInput struct {
+	ID string `validate:"required,min=1,max=255"`
+}
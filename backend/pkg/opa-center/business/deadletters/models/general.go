@@ -0,0 +1,32 @@
+package models
+
+import "github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
+
+type SortOrder struct {
+	CreatedAt  *common.SortOrderEnum `dbfield:"created_at"`
+	UpdatedAt  *common.SortOrderEnum `dbfield:"updated_at"`
+	Kind       *common.SortOrderEnum `dbfield:"kind"`
+	ReplayedAt *common.SortOrderEnum `dbfield:"replayed_at"`
+}
+
+type Filter struct {
+	AND         []*Filter
+	OR          []*Filter
+	CreatedAt   *common.DateFilter    `dbfield:"created_at"`
+	UpdatedAt   *common.DateFilter    `dbfield:"updated_at"`
+	Kind        *common.GenericFilter `dbfield:"kind"`
+	Reason      *common.GenericFilter `dbfield:"reason"`
+	ReplayedAt  *common.DateFilter    `dbfield:"replayed_at"`
+	PartitionID *common.GenericFilter `dbfield:"partition_id"`
+}
+
+type Projection struct {
+	ID          bool `dbfield:"id" graphqlfield:"id"`
+	CreatedAt   bool `dbfield:"created_at" graphqlfield:"createdAt"`
+	UpdatedAt   bool `dbfield:"updated_at" graphqlfield:"updatedAt"`
+	Kind        bool `dbfield:"kind" graphqlfield:"kind"`
+	Reason      bool `dbfield:"reason" graphqlfield:"reason"`
+	Body        bool `dbfield:"body" graphqlfield:"body"`
+	ReplayedAt  bool `dbfield:"replayed_at" graphqlfield:"replayedAt"`
+	PartitionID bool `dbfield:"partition_id" graphqlfield:"partition"`
+}
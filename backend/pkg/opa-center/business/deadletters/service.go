@@ -0,0 +1,334 @@
+package deadletters
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authorization"
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/daos"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+)
+
+const mainAuthorizationPrefix = "deadletters"
+
+// Number of dead letters removed in one request when a partition is purged.
+const purgeBatchSize = 1000
+
+type service struct {
+	dao              daos.Dao
+	validator        *validator.Validate
+	authorizationSvc authorization.Service
+	partitionSvc     PartitionService
+	decisionLogsSvc  DecisionLogsService
+	statusesSvc      StatusesService
+}
+
+func (s *service) MigrateDB(systemLogger log.Logger) error {
+	systemLogger.Debug("Migrate database for Dead Letters")
+
+	return s.dao.MigrateDB()
+}
+
+func (s *service) UnsecureCreate(kind models.KindEnum, partitionID, reason string, body []byte) error {
+	// Create dead letter object
+	// Checksum is used in order to store the same rejected payload only once
+	dl := &models.DeadLetter{
+		Kind:        kind,
+		PartitionID: partitionID,
+		Reason:      reason,
+		Body:        string(body),
+		Checksum:    computeChecksum([]byte(kind), []byte(partitionID), body),
+	}
+
+	// Validate input
+	err := s.validator.Struct(dl)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	return s.dao.CreateIfNotExists(dl)
+}
+
+// computeChecksum will compute checksum of fields.
+// Each field is prefixed by its length in order to not have the same checksum for different fields.
+func computeChecksum(fields ...[]byte) string {
+	// Create hash
+	h := sha256.New()
+	// Loop over fields
+	for _, f := range fields {
+		// Add length prefix
+		// Writing in a hash never returns an error
+		_ = binary.Write(h, binary.BigEndian, uint64(len(f)))
+		// Add data
+		h.Write(f)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (s *service) ManageRetention(
+	logger log.Logger,
+	retentionDuration time.Duration,
+	partitionID string,
+	kind models.KindEnum,
+) error {
+	// Get now date
+	now := time.Now()
+	// Remove duration
+	oldDate := now.Add(-retentionDuration)
+	// Format date
+	oldDateS := oldDate.Format(time.RFC3339)
+
+	return s.dao.Delete(&models.Filter{
+		CreatedAt:   &common.DateFilter{Lt: &oldDateS},
+		PartitionID: &common.GenericFilter{Eq: partitionID},
+		Kind:        &common.GenericFilter{Eq: kind.String()},
+	})
+}
+
+func (s *service) PurgePartition(logger log.Logger, partitionID string) error {
+	// Total number of deleted elements
+	var total int64
+	// Delete batches until partition is empty
+	for {
+		// Delete batch
+		count, err := s.dao.DeleteBatchByPartitionID(partitionID, purgeBatchSize)
+		// Check error
+		if err != nil {
+			return err
+		}
+		// Update total
+		total += count
+		// Check if this was the last batch
+		if count < purgeBatchSize {
+			break
+		}
+	}
+
+	logger.Infof("%d dead letters removed from partition %s", total, partitionID)
+
+	return nil
+}
+
+func (s *service) FindByID(ctx context.Context, id string, projection *models.Projection) (*models.DeadLetter, error) {
+	return s.findByID(ctx, "FindByID", id, projection)
+}
+
+// findByID will find dead letter and check action on it.
+// Authorization is checked before answering that dead letter doesn't exist.
+func (s *service) findByID(ctx context.Context, action, id string, projection *models.Projection) (*models.DeadLetter, error) {
+	// Add fields needed for authorization
+	addAuthorizationProjection(projection)
+	// Find dead letter
+	res, err := s.dao.FindByID(id, projection)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Find partition of dead letter
+	res, partition, err := s.findPartition(res)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check authorization
+	err = s.authorizationSvc.CheckAuthorizedOnResource(
+		ctx,
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, action),
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id),
+		getAuthorizationResource(id, res, partition),
+	)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// findPartition will find partition of dead letter in order to build authorization resource.
+// Dead letter is hidden when its partition is deleted.
+func (s *service) findPartition(dl *models.DeadLetter) (*models.DeadLetter, *pmodels.Partition, error) {
+	// Check if dead letter exists
+	if dl == nil {
+		return nil, nil, nil
+	}
+
+	// Find partition
+	partition, err := s.partitionSvc.UnsecureFindByID(dl.PartitionID)
+	// Check error
+	if err != nil {
+		return nil, nil, err
+	}
+	// Check if partition is deleted
+	if partition == nil {
+		return nil, nil, nil
+	}
+
+	return dl, partition, nil
+}
+
+func (s *service) GetAllPaginated(
+	ctx context.Context,
+	page *pagination.PageInput,
+	sort *models.SortOrder,
+	filter *models.Filter,
+	projection *models.Projection,
+) ([]*models.DeadLetter, *pagination.PageOutput, error) {
+	// Get authorized partitions
+	ids, err := s.partitionSvc.FindAuthorizedIDs(ctx, nil, nil)
+	// Check error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check authorization
+	err = s.authorizationSvc.CheckAuthorizedOnResource(
+		ctx,
+		fmt.Sprintf("%s:List", mainAuthorizationPrefix),
+		"",
+		&authxmodels.Resource{Type: mainAuthorizationPrefix},
+	)
+	// Check error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Create list of filters
+	// Filter is wrapped in order to not allow OR filters to escape partition restriction
+	ands := make([]*models.Filter, 0)
+	// Check if filter is set
+	if filter != nil {
+		ands = append(ands, filter)
+	}
+
+	// Create filter with partition filter
+	filter = &models.Filter{AND: ands, PartitionID: &common.GenericFilter{In: ids}}
+
+	return s.dao.GetAllPaginated(page, sort, filter, projection)
+}
+
+func (s *service) Replay(ctx context.Context, inp *models.ReplayInput) (*models.DeadLetter, error) {
+	// Get logger from context
+	logger := log.GetLoggerFromContext(ctx)
+
+	// Validate input
+	err := s.validator.Struct(inp)
+	// Check error
+	if err != nil {
+		return nil, errors.NewInvalidInputErrorWithError(err)
+	}
+
+	// Find dead letter and check authorization
+	res, err := s.findByID(ctx, "Replay", inp.ID, nil)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if exists
+	if res == nil {
+		return nil, errors.NewNotFoundError("dead letter not found")
+	}
+	// Check if it has already been replayed
+	if res.ReplayedAt != nil {
+		return nil, errors.NewConflictError("dead letter has already been replayed")
+	}
+
+	// Flag as replayed before replaying in order to have only one replay when called concurrently
+	// Date is truncated to database precision in order to find it again
+	now := time.Now().Truncate(time.Microsecond)
+	// Mark as replayed
+	marked, err := s.dao.MarkReplayed(res.ID, now)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if another replay has been done in the meantime
+	if !marked {
+		return nil, errors.NewConflictError("dead letter has already been replayed")
+	}
+
+	// Replay payload
+	err = s.replay(res)
+	// Check error
+	if err != nil {
+		// Remove replay flag in order to allow another try
+		err2 := s.dao.UnmarkReplayed(res.ID, now)
+		// Check error
+		if err2 != nil {
+			logger.Error(err2)
+		}
+
+		return nil, err
+	}
+
+	// Update result
+	res.ReplayedAt = &now
+
+	// Log
+	logger.Infof("Dead letter %s successfully replayed", res.ID)
+
+	return res, nil
+}
+
+func (s *service) replay(dl *models.DeadLetter) error {
+	// Switch on kind
+	switch dl.Kind {
+	case models.KindEnumDecisionLogs:
+		// Parse body
+		var mm []map[string]interface{}
+
+		err := json.Unmarshal([]byte(dl.Body), &mm)
+		// Check error
+		if err != nil {
+			return errors.NewInvalidInputErrorWithError(err)
+		}
+
+		// Call service
+		res, err := s.decisionLogsSvc.UnsecureCreate(dl.PartitionID, mm)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Check if some entries are still rejected
+		// Already accepted ones will be ignored on next replay
+		if len(res.Rejected) != 0 {
+			return errors.NewInvalidInputErrorWithExtensions(
+				"some decision logs are still invalid",
+				map[string]interface{}{"rejected": res.Rejected},
+			)
+		}
+
+		return nil
+	case models.KindEnumStatus:
+		// Parse body
+		var mm map[string]interface{}
+
+		err := json.Unmarshal([]byte(dl.Body), &mm)
+		// Check error
+		if err != nil {
+			return errors.NewInvalidInputErrorWithError(err)
+		}
+
+		// Call service
+		return s.statusesSvc.UnsecureCreate(dl.PartitionID, mm)
+	default:
+		return errors.NewInternalServerError(fmt.Sprintf("dead letter kind %s not supported", dl.Kind))
+	}
+}
@@ -0,0 +1,315 @@
+//+build unit
+
+package deadletters
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	amocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authorization/mocks"
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	daosmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/daos/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	dlmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/mocks"
+	dlmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	smocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_service_Replay(t *testing.T) {
+	replayedAt := time.Now()
+	partition := &pmodels.Partition{Name: "fake"}
+	partition.ID = "p1"
+
+	tests := []struct {
+		name           string
+		authorizedErr  error
+		deadLetter     *models.DeadLetter
+		markResult     bool
+		replayRes      *dlmodels.IngestionResult
+		replayErr      error
+		wantUnmark     bool
+		wantStatusCode int
+	}{
+		{
+			name:           "forbidden",
+			deadLetter:     &models.DeadLetter{ID: "id1", Kind: models.KindEnumDecisionLogs, PartitionID: "p1", Body: `[{}]`},
+			authorizedErr:  errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "forbidden on missing dead letter",
+			authorizedErr:  errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "not found",
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "already replayed",
+			deadLetter:     &models.DeadLetter{ID: "id1", Kind: models.KindEnumDecisionLogs, PartitionID: "p1", ReplayedAt: &replayedAt},
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "replayed concurrently",
+			deadLetter:     &models.DeadLetter{ID: "id1", Kind: models.KindEnumDecisionLogs, PartitionID: "p1", Body: `[{}]`},
+			markResult:     false,
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:       "decision logs replayed",
+			deadLetter: &models.DeadLetter{ID: "id1", Kind: models.KindEnumDecisionLogs, PartitionID: "p1", Body: `[{}]`},
+			markResult: true,
+			replayRes:  &dlmodels.IngestionResult{Accepted: 1, Rejected: []*dlmodels.RejectedEntry{}},
+		},
+		{
+			name:       "decision logs still invalid",
+			deadLetter: &models.DeadLetter{ID: "id1", Kind: models.KindEnumDecisionLogs, PartitionID: "p1", Body: `[{}]`},
+			markResult: true,
+			replayRes: &dlmodels.IngestionResult{
+				Rejected: []*dlmodels.RejectedEntry{{Index: 0, Reason: "fake"}},
+			},
+			wantUnmark:     true,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "temporary failure",
+			deadLetter:     &models.DeadLetter{ID: "id1", Kind: models.KindEnumDecisionLogs, PartitionID: "p1", Body: `[{}]`},
+			markResult:     true,
+			replayErr:      errors.NewInternalServerError("fake"),
+			wantUnmark:     true,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:       "status replayed",
+			deadLetter: &models.DeadLetter{ID: "id1", Kind: models.KindEnumStatus, PartitionID: "p1", Body: `{}`},
+			markResult: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authoSvcMock := amocks.NewMockService(ctrl)
+			daoMock := daosmocks.NewMockDao(ctrl)
+			dlSvcMock := dlmocks.NewMockService(ctrl)
+			stSvcMock := smocks.NewMockService(ctrl)
+			pSvcMock := mocks.NewMockPartitionService(ctrl)
+
+			daoMock.EXPECT().FindByID("id1", nil).Return(tt.deadLetter, nil)
+
+			// Resource sent to OPA
+			resource := &authxmodels.Resource{Type: "deadletters", ID: "id1"}
+			if tt.deadLetter != nil {
+				pSvcMock.EXPECT().UnsecureFindByID("p1").Return(partition, nil)
+				resource.Attributes = map[string]interface{}{
+					"kind":      tt.deadLetter.Kind.String(),
+					"partition": partition.GetAuthorizationResource(),
+				}
+			}
+
+			authoSvcMock.EXPECT().
+				CheckAuthorizedOnResource(gomock.Any(), "deadletters:Replay", "deadletters:id1", resource).
+				Return(tt.authorizedErr)
+
+			// Replay date set by service
+			var markedAt time.Time
+
+			if tt.authorizedErr == nil && tt.deadLetter != nil && tt.deadLetter.ReplayedAt == nil {
+				daoMock.EXPECT().MarkReplayed("id1", gomock.Any()).DoAndReturn(func(_ string, at time.Time) (bool, error) {
+					markedAt = at
+
+					return tt.markResult, nil
+				})
+			}
+
+			if tt.markResult {
+				switch tt.deadLetter.Kind {
+				case models.KindEnumDecisionLogs:
+					dlSvcMock.EXPECT().UnsecureCreate("p1", []map[string]interface{}{{}}).Return(tt.replayRes, tt.replayErr)
+				case models.KindEnumStatus:
+					stSvcMock.EXPECT().UnsecureCreate("p1", map[string]interface{}{}).Return(tt.replayErr)
+				}
+			}
+
+			if tt.wantUnmark {
+				daoMock.EXPECT().UnmarkReplayed("id1", gomock.Any()).DoAndReturn(func(_ string, at time.Time) error {
+					assert.Equal(t, markedAt, at)
+
+					return nil
+				})
+			}
+
+			s := &service{
+				dao:              daoMock,
+				validator:        validator.New(),
+				authorizationSvc: authoSvcMock,
+				partitionSvc:     pSvcMock,
+				decisionLogsSvc:  dlSvcMock,
+				statusesSvc:      stSvcMock,
+			}
+
+			ctx := log.SetLoggerToContext(context.TODO(), log.NewLogger())
+
+			got, err := s.Replay(ctx, &models.ReplayInput{ID: "id1"})
+			if tt.wantStatusCode != 0 {
+				// nolint: errorlint // Ignore this because the aim is to catch project error at first level
+				err2, ok := err.(errors.Error)
+				if assert.True(t, ok) {
+					assert.Equal(t, tt.wantStatusCode, err2.StatusCode())
+				}
+
+				return
+			}
+
+			assert.NoError(t, err)
+			if assert.NotNil(t, got.ReplayedAt) {
+				assert.Equal(t, markedAt, *got.ReplayedAt)
+			}
+		})
+	}
+}
+
+func Test_computeChecksum(t *testing.T) {
+	// Fields moved from one to another must not have the same checksum
+	assert.NotEqual(t, computeChecksum([]byte("STATUS"), []byte("p1"), []byte("{}")), computeChecksum([]byte("STATUS"), []byte("p"), []byte("1{}")))
+	assert.Equal(t, computeChecksum([]byte("STATUS"), []byte("p1"), []byte("{}")), computeChecksum([]byte("STATUS"), []byte("p1"), []byte("{}")))
+}
+
+func Test_service_FindByID(t *testing.T) {
+	partition := &pmodels.Partition{Name: "fake"}
+	partition.ID = "p1"
+
+	tests := []struct {
+		name          string
+		deadLetter    *models.DeadLetter
+		partition     *pmodels.Partition
+		authorizedErr error
+		wantResource  *authxmodels.Resource
+		want          *models.DeadLetter
+		wantErr       bool
+	}{
+		{
+			name:       "found",
+			deadLetter: &models.DeadLetter{ID: "id1", Kind: models.KindEnumStatus, PartitionID: "p1"},
+			partition:  partition,
+			wantResource: &authxmodels.Resource{
+				Type: "deadletters",
+				ID:   "id1",
+				Attributes: map[string]interface{}{
+					"kind":      "STATUS",
+					"partition": partition.GetAuthorizationResource(),
+				},
+			},
+			want: &models.DeadLetter{ID: "id1", Kind: models.KindEnumStatus, PartitionID: "p1"},
+		},
+		{
+			name:         "not found is checked like a missing dead letter",
+			wantResource: &authxmodels.Resource{Type: "deadletters", ID: "id1"},
+		},
+		{
+			name:         "partition deleted hides dead letter",
+			deadLetter:   &models.DeadLetter{ID: "id1", Kind: models.KindEnumStatus, PartitionID: "p1"},
+			wantResource: &authxmodels.Resource{Type: "deadletters", ID: "id1"},
+		},
+		{
+			name:          "forbidden on missing dead letter",
+			wantResource:  &authxmodels.Resource{Type: "deadletters", ID: "id1"},
+			authorizedErr: errors.NewForbiddenError("forbidden"),
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authoSvcMock := amocks.NewMockService(ctrl)
+			daoMock := daosmocks.NewMockDao(ctrl)
+			pSvcMock := mocks.NewMockPartitionService(ctrl)
+
+			daoMock.EXPECT().
+				FindByID("id1", &models.Projection{ID: true, Kind: true, PartitionID: true}).
+				Return(tt.deadLetter, nil)
+			if tt.deadLetter != nil {
+				pSvcMock.EXPECT().UnsecureFindByID("p1").Return(tt.partition, nil)
+			}
+			authoSvcMock.EXPECT().
+				CheckAuthorizedOnResource(gomock.Any(), "deadletters:FindByID", "deadletters:id1", tt.wantResource).
+				Return(tt.authorizedErr)
+
+			s := &service{dao: daoMock, authorizationSvc: authoSvcMock, partitionSvc: pSvcMock}
+
+			got, err := s.FindByID(context.TODO(), "id1", &models.Projection{})
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_service_GetAllPaginated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authoSvcMock := amocks.NewMockService(ctrl)
+	daoMock := daosmocks.NewMockDao(ctrl)
+	pSvcMock := mocks.NewMockPartitionService(ctrl)
+
+	filter := &models.Filter{OR: []*models.Filter{{Kind: &common.GenericFilter{Eq: "STATUS"}}}}
+
+	pSvcMock.EXPECT().FindAuthorizedIDs(gomock.Any(), nil, nil).Return([]string{"p1"}, nil)
+	authoSvcMock.EXPECT().
+		CheckAuthorizedOnResource(gomock.Any(), "deadletters:List", "", &authxmodels.Resource{Type: "deadletters"}).
+		Return(nil)
+	// Filter must be restricted to authorized partitions
+	daoMock.EXPECT().GetAllPaginated(nil, nil, &models.Filter{
+		AND:         []*models.Filter{filter},
+		PartitionID: &common.GenericFilter{In: []string{"p1"}},
+	}, nil).Return([]*models.DeadLetter{}, &pagination.PageOutput{}, nil)
+
+	s := &service{dao: daoMock, authorizationSvc: authoSvcMock, partitionSvc: pSvcMock}
+
+	_, _, err := s.GetAllPaginated(context.TODO(), nil, nil, filter, nil)
+	assert.NoError(t, err)
+}
+
+func Test_service_ManageRetention(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	daoMock := daosmocks.NewMockDao(ctrl)
+	daoMock.EXPECT().Delete(gomock.Any()).DoAndReturn(func(filter *models.Filter) error {
+		assert.Equal(t, &common.GenericFilter{Eq: "p1"}, filter.PartitionID)
+		assert.Equal(t, &common.GenericFilter{Eq: "STATUS"}, filter.Kind)
+		if assert.NotNil(t, filter.CreatedAt) && assert.NotNil(t, filter.CreatedAt.Lt) {
+			lt, err := time.Parse(time.RFC3339, *filter.CreatedAt.Lt)
+			assert.NoError(t, err)
+			assert.WithinDuration(t, time.Now().Add(-time.Hour), lt, time.Minute)
+		}
+
+		return nil
+	})
+
+	s := &service{dao: daoMock}
+
+	err := s.ManageRetention(log.NewLogger(), time.Hour, "p1", models.KindEnumStatus)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,42 @@
+package decisionlogs
+
+import (
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+)
+
+// getAuthorizationResource will return resource sent to OPA for a decision log.
+// Only type and id are known when decision log doesn't exist.
+func getAuthorizationResource(id string, dl *models.DecisionLog, partition *pmodels.Partition) *authxmodels.Resource {
+	// Create resource
+	res := &authxmodels.Resource{Type: mainAuthorizationPrefix, ID: id}
+	// Check if decision log exists
+	if dl == nil {
+		return res
+	}
+
+	// Store id
+	res.ID = dl.ID
+	// Store attributes
+	res.Attributes = map[string]interface{}{
+		"decision_id": dl.DecisionID,
+		"path":        dl.Path,
+		"partition":   pmodels.NewAuthorizationResource(dl.PartitionID, partition),
+	}
+
+	return res
+}
+
+// addAuthorizationProjection will add fields needed for authorization in projection.
+func addAuthorizationProjection(projection *models.Projection) {
+	// Check if a projection is used
+	if projection == nil {
+		return
+	}
+
+	projection.ID = true
+	projection.DecisionID = true
+	projection.Path = true
+	projection.PartitionID = true
+}
@@ -0,0 +1,79 @@
+//+build unit
+
+package decisionlogs
+
+import (
+	"testing"
+
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getAuthorizationResource(t *testing.T) {
+	partition := &pmodels.Partition{
+		Base:   database.Base{ID: "p1"},
+		Name:   "fake",
+		Labels: pmodels.Labels{"team": "a"},
+		Owners: pmodels.Owners{"owner@example.com"},
+	}
+	dl := &models.DecisionLog{DecisionID: "d1", Path: "a/b", PartitionID: "p1"}
+	dl.ID = "dl1"
+
+	tests := []struct {
+		name      string
+		id        string
+		dl        *models.DecisionLog
+		partition *pmodels.Partition
+		want      *authxmodels.Resource
+	}{
+		{
+			name: "decision log not found",
+			id:   "dl1",
+			want: &authxmodels.Resource{Type: "decisionlogs", ID: "dl1"},
+		},
+		{
+			name:      "decision log with partition",
+			dl:        dl,
+			partition: partition,
+			want: &authxmodels.Resource{
+				Type: "decisionlogs",
+				ID:   "dl1",
+				Attributes: map[string]interface{}{
+					"decision_id": "d1",
+					"path":        "a/b",
+					"partition": &authxmodels.Resource{
+						Type: "partitions",
+						ID:   "p1",
+						Name: "fake",
+						Attributes: map[string]interface{}{
+							"labels": pmodels.Labels{"team": "a"},
+							"owners": pmodels.Owners{"owner@example.com"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "decision log without partition",
+			dl:   dl,
+			want: &authxmodels.Resource{
+				Type: "decisionlogs",
+				ID:   "dl1",
+				Attributes: map[string]interface{}{
+					"decision_id": "d1",
+					"path":        "a/b",
+					"partition":   &authxmodels.Resource{Type: "partitions", ID: "p1"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getAuthorizationResource(tt.id, tt.dl, tt.partition)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -9,17 +9,22 @@ import (
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/daos"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
 	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
 )
 
+//go:generate mockgen -destination=./mocks/mock_Service.go -package=mocks github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs Service
 type Service interface {
 	// Migrate database
 	MigrateDB(systemLogger log.Logger) error
 	// Create decision log used internally only
-	UnsecureCreate(partitionName string, inp []map[string]interface{}) error
+	// Result will list accepted and rejected entries
+	UnsecureCreate(partitionID string, inp []map[string]interface{}) (*models.IngestionResult, error)
 	// Get data paginated
+	// Search is a full text search, results are sorted by relevance when no sort is given
+	// Query is a filter query like "path:authz/allow AND outcome:deny" added to filter
 	GetAllPaginated(
 		ctx context.Context,
 		partitionID string,
@@ -27,20 +32,44 @@ type Service interface {
 		sort *models.SortOrder,
 		filter *models.Filter,
 		projection *models.Projection,
+		search *string,
+		query *string,
+	) ([]*models.DecisionLog, *pagination.PageOutput, error)
+	// Get data paginated across all partitions that caller is authorized to read
+	// Partition id and name are optional filters on partitions
+	GetAllPaginatedInPartitions(
+		ctx context.Context,
+		partitionID, partitionName *string,
+		page *pagination.PageInput,
+		sort *models.SortOrder,
+		filter *models.Filter,
+		projection *models.Projection,
+		search *string,
+		query *string,
 	) ([]*models.DecisionLog, *pagination.PageOutput, error)
 	// Find by id or decision id
 	FindByIDOrDecisionID(ctx context.Context, id, did *string, projection *models.Projection) (*models.DecisionLog, error)
 	// Manage retention data
 	ManageRetention(logger log.Logger, retentionDuration time.Duration, partitionID string) error
+	// Remove permanently all data of a partition in batches
+	PurgePartition(logger log.Logger, partitionID string) error
 }
 
+//go:generate mockgen -destination=./mocks/mock_PartitionService.go -package=mocks github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs PartitionService
 type PartitionService interface {
 	UnsecureFindByID(id string) (*pmodels.Partition, error)
+	FindAuthorizedIDs(ctx context.Context, id, name *string) ([]string, error)
 }
 
-func NewService(db database.DB, authoSvc authorization.Service, partitionSvc PartitionService) Service {
+func NewService(db database.DB, authoSvc authorization.Service, partitionSvc PartitionService, cfgManager config.Manager) Service {
 	// Create dao
 	dao := daos.NewDao(db)
 
-	return &service{dao: dao, validator: validator.New(), partitionSvc: partitionSvc, authorizationSvc: authoSvc}
+	return &service{
+		dao:              dao,
+		validator:        validator.New(),
+		partitionSvc:     partitionSvc,
+		authorizationSvc: authoSvc,
+		cfgManager:       cfgManager,
+	}
 }
@@ -6,25 +6,39 @@ import (
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
 )
 
+//go:generate mockgen -destination=./mocks/mock_Dao.go -package=mocks github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/daos Dao
+
 // Dao represent a decision logs access object service.
 type Dao interface {
 	// MigrateDB will migrate database
 	MigrateDB() error
-	// Save will save object in database
-	Save(ins *models.DecisionLog) error
+	// CreateMany will insert all objects in one transaction and ignore already existing decision ids
+	CreateMany(list []*models.DecisionLog) error
 	// FindOneByDecisionID will find one decision log by decision id
 	FindOneByDecisionID(did string, projection *models.Projection) (*models.DecisionLog, error)
 	// FindByID will find by id
 	FindByID(di string, projection *models.Projection) (*models.DecisionLog, error)
 	// Get data paginated
+	// Search is a full text search, results are sorted by relevance when no sort is given
 	GetAllPaginated(
 		page *pagination.PageInput,
 		sort *models.SortOrder,
 		filter *models.Filter,
 		projection *models.Projection,
+		search *string,
 	) ([]*models.DecisionLog, *pagination.PageOutput, error)
+	// ComputeSearchVectors will compute full text search vector of at most limit decision logs without it
+	// It returns the number of updated decision logs
+	ComputeSearchVectors(limit int) (int64, error)
+	// FindOutdatedExtractions will find decision logs with fields extracted with an older version than the one given
+	// Only id, original message, partition id and extraction version are loaded
+	FindOutdatedExtractions(version, limit int) ([]*models.DecisionLog, error)
+	// UpdateExtractedFields will save only extracted fields of all objects in one transaction
+	UpdateExtractedFields(list []*models.DecisionLog) error
 	// Delete permanently with filter
 	Delete(filter *models.Filter) error
+	// Delete permanently at most limit elements of a partition and return the number of deleted elements
+	DeleteBatchByPartitionID(partitionID string, limit int) (int64, error)
 }
 
 func NewDao(db database.DB) Dao {
@@ -1,26 +1,86 @@
 package daos
 
 import (
+	"encoding/json"
+	"sort"
+
 	daomodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/daos/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
 	"gorm.io/datatypes"
 )
 
-func toDao(ins *models.DecisionLog) *daomodels.DecisionLog {
+// bundleDao represents a bundle value in the OPA bundles object.
+type bundleDao struct {
+	Revision string `json:"revision"`
+}
+
+func toDao(ins *models.DecisionLog) (*daomodels.DecisionLog, error) {
 	val := &daomodels.DecisionLog{
-		DecisionID:      ins.DecisionID,
-		Path:            ins.Path,
-		RequestedBy:     ins.RequestedBy,
-		Timestamp:       ins.Timestamp,
-		OriginalMessage: datatypes.JSON([]byte(ins.OriginalMessage)),
-		PartitionID:     ins.PartitionID,
+		DecisionID:           ins.DecisionID,
+		Path:                 ins.Path,
+		RequestedBy:          ins.RequestedBy,
+		Timestamp:            ins.Timestamp,
+		OriginalMessage:      datatypes.JSON([]byte(ins.OriginalMessage)),
+		PartitionID:          ins.PartitionID,
+		Result:               stringToJSON(ins.Result),
+		Input:                stringToJSON(ins.Input),
+		InstanceID:           ins.InstanceID,
+		BundleRevisions:      ins.BundleRevisions,
+		Metrics:              stringToJSON(ins.Metrics),
+		TimerServerHandlerNs: ins.TimerServerHandlerNs,
+		TimerRegoQueryEvalNs: ins.TimerRegoQueryEvalNs,
+		ReqID:                ins.ReqID,
+		Outcome:              ins.Outcome.String(),
+		ExtractionVersion:    ins.ExtractionVersion,
 	}
 	// Add other data
 	val.ID = ins.ID
 	val.CreatedAt = ins.CreatedAt
 	val.UpdatedAt = ins.UpdatedAt
 
-	return val
+	// Check if bundles are present
+	if ins.Bundles != nil {
+		// Rebuild OPA bundles object
+		bundles := map[string]*bundleDao{}
+		// Loop over bundles
+		for _, b := range ins.Bundles {
+			bundles[b.Name] = &bundleDao{Revision: b.Revision}
+		}
+		// Marshal
+		bb, err := json.Marshal(bundles)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		val.Bundles = datatypes.JSON(bb)
+	}
+
+	// Check if erased is present
+	if ins.Erased != nil {
+		// Marshal
+		bb, err := json.Marshal(ins.Erased)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		val.Erased = datatypes.JSON(bb)
+	}
+
+	// Check if masked is present
+	if ins.Masked != nil {
+		// Marshal
+		bb, err := json.Marshal(ins.Masked)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		val.Masked = datatypes.JSON(bb)
+	}
+
+	return val, nil
 }
 
 func fromDao(ins *daomodels.DecisionLog) (*models.DecisionLog, error) {
@@ -32,16 +92,90 @@ func fromDao(ins *daomodels.DecisionLog) (*models.DecisionLog, error) {
 	}
 
 	val := &models.DecisionLog{
-		ID:              ins.ID,
-		CreatedAt:       ins.CreatedAt,
-		UpdatedAt:       ins.UpdatedAt,
-		DecisionID:      ins.DecisionID,
-		Path:            ins.Path,
-		RequestedBy:     ins.RequestedBy,
-		Timestamp:       ins.Timestamp,
-		OriginalMessage: string(bb),
-		PartitionID:     ins.PartitionID,
+		ID:                   ins.ID,
+		CreatedAt:            ins.CreatedAt,
+		UpdatedAt:            ins.UpdatedAt,
+		DecisionID:           ins.DecisionID,
+		Path:                 ins.Path,
+		RequestedBy:          ins.RequestedBy,
+		Timestamp:            ins.Timestamp,
+		OriginalMessage:      string(bb),
+		PartitionID:          ins.PartitionID,
+		Result:               jsonToString(ins.Result),
+		Input:                jsonToString(ins.Input),
+		InstanceID:           ins.InstanceID,
+		BundleRevisions:      ins.BundleRevisions,
+		Metrics:              jsonToString(ins.Metrics),
+		TimerServerHandlerNs: ins.TimerServerHandlerNs,
+		TimerRegoQueryEvalNs: ins.TimerRegoQueryEvalNs,
+		ReqID:                ins.ReqID,
+		Outcome:              models.OutcomeEnum(ins.Outcome),
+		ExtractionVersion:    ins.ExtractionVersion,
+	}
+
+	// Check if bundles are present
+	if len(ins.Bundles) != 0 {
+		// Parse OPA bundles object
+		bundles := map[string]*bundleDao{}
+		// Unmarshal
+		err = json.Unmarshal(ins.Bundles, &bundles)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		// Create list
+		val.Bundles = make([]*models.Bundle, 0, len(bundles))
+		// Loop over bundles
+		for name, b := range bundles {
+			val.Bundles = append(val.Bundles, &models.Bundle{Name: name, Revision: b.Revision})
+		}
+		// Sort by name in order to have a stable result
+		sort.Slice(val.Bundles, func(i, j int) bool { return val.Bundles[i].Name < val.Bundles[j].Name })
+	}
+
+	// Check if erased is present
+	if len(ins.Erased) != 0 {
+		// Unmarshal
+		err = json.Unmarshal(ins.Erased, &val.Erased)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Check if masked is present
+	if len(ins.Masked) != 0 {
+		// Unmarshal
+		err = json.Unmarshal(ins.Masked, &val.Masked)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return val, nil
 }
+
+// stringToJSON will transform an optional JSON string to a database JSON value.
+func stringToJSON(s *string) datatypes.JSON {
+	// Check if value is present
+	if s == nil {
+		return nil
+	}
+
+	return datatypes.JSON([]byte(*s))
+}
+
+// jsonToString will transform a database JSON value to an optional JSON string.
+func jsonToString(j datatypes.JSON) *string {
+	// Check if value is present
+	if len(j) == 0 {
+		return nil
+	}
+
+	// Transform in string
+	s := string(j)
+
+	return &s
+}
@@ -15,14 +15,22 @@ import (
 
 func Test_toDao(t *testing.T) {
 	now := time.Now()
+	result := `true`
+	input := `{"user":"fake"}`
+	instanceID := "fake instance"
+	revisions := "r1"
+	metrics := `{"timer_server_handler_ns":10}`
+	timer := int64(10)
+	reqID := int64(1)
 
 	type args struct {
 		ins *models.DecisionLog
 	}
 	tests := []struct {
-		name string
-		args args
-		want *daomodels.DecisionLog
+		name    string
+		args    args
+		want    *daomodels.DecisionLog
+		wantErr bool
 	}{
 		{
 			name: "empty input",
@@ -66,10 +74,47 @@ func Test_toDao(t *testing.T) {
 					`)),
 			},
 		},
+		{
+			name: "extracted fields",
+			args: args{
+				ins: &models.DecisionLog{
+					OriginalMessage:      `{}`,
+					Result:               &result,
+					Input:                &input,
+					InstanceID:           &instanceID,
+					Bundles:              []*models.Bundle{{Name: "b1", Revision: "r1"}},
+					BundleRevisions:      &revisions,
+					Metrics:              &metrics,
+					TimerServerHandlerNs: &timer,
+					ReqID:                &reqID,
+					Erased:               []string{"/input/password"},
+					Masked:               []string{},
+					ExtractionVersion:    1,
+				},
+			},
+			want: &daomodels.DecisionLog{
+				OriginalMessage:      datatypes.JSON([]byte(`{}`)),
+				Result:               datatypes.JSON([]byte(`true`)),
+				Input:                datatypes.JSON([]byte(`{"user":"fake"}`)),
+				InstanceID:           &instanceID,
+				Bundles:              datatypes.JSON([]byte(`{"b1":{"revision":"r1"}}`)),
+				BundleRevisions:      &revisions,
+				Metrics:              datatypes.JSON([]byte(`{"timer_server_handler_ns":10}`)),
+				TimerServerHandlerNs: &timer,
+				ReqID:                &reqID,
+				Erased:               datatypes.JSON([]byte(`["/input/password"]`)),
+				Masked:               datatypes.JSON([]byte(`[]`)),
+				ExtractionVersion:    1,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := toDao(tt.args.ins)
+			got, err := toDao(tt.args.ins)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("toDao() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
 			if got != nil {
 				assert.Equal(t, tt.want, got)
 			}
@@ -79,6 +124,10 @@ func Test_toDao(t *testing.T) {
 
 func Test_fromDao(t *testing.T) {
 	now := time.Now()
+	result := `true`
+	instanceID := "fake instance"
+	revisions := "r1,r2"
+	timer := int64(10)
 
 	type args struct {
 		ins *daomodels.DecisionLog
@@ -118,6 +167,41 @@ func Test_fromDao(t *testing.T) {
 				PartitionID:     "fake pid",
 			},
 		},
+		{
+			name: "extracted fields",
+			args: args{
+				ins: &daomodels.DecisionLog{
+					OriginalMessage:      datatypes.JSON([]byte(`{}`)),
+					Result:               datatypes.JSON([]byte(`true`)),
+					InstanceID:           &instanceID,
+					Bundles:              datatypes.JSON([]byte(`{"b2":{"revision":"r2"},"b1":{"revision":"r1"}}`)),
+					BundleRevisions:      &revisions,
+					TimerRegoQueryEvalNs: &timer,
+					Masked:               datatypes.JSON([]byte(`["/input/password"]`)),
+					ExtractionVersion:    1,
+				},
+			},
+			want: &models.DecisionLog{
+				OriginalMessage:      `{}`,
+				Result:               &result,
+				InstanceID:           &instanceID,
+				Bundles:              []*models.Bundle{{Name: "b1", Revision: "r1"}, {Name: "b2", Revision: "r2"}},
+				BundleRevisions:      &revisions,
+				TimerRegoQueryEvalNs: &timer,
+				Masked:               []string{"/input/password"},
+				ExtractionVersion:    1,
+			},
+		},
+		{
+			name: "invalid bundles",
+			args: args{
+				ins: &daomodels.DecisionLog{
+					OriginalMessage: datatypes.JSON([]byte(`{}`)),
+					Bundles:         datatypes.JSON([]byte(`[]`)),
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,182 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/daos (interfaces: Dao)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	pagination "github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
+	reflect "reflect"
+)
+
+// MockDao is a mock of Dao interface
+type MockDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockDaoMockRecorder
+}
+
+// MockDaoMockRecorder is the mock recorder for MockDao
+type MockDaoMockRecorder struct {
+	mock *MockDao
+}
+
+// NewMockDao creates a new mock instance
+func NewMockDao(ctrl *gomock.Controller) *MockDao {
+	mock := &MockDao{ctrl: ctrl}
+	mock.recorder = &MockDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDao) EXPECT() *MockDaoMockRecorder {
+	return m.recorder
+}
+
+// ComputeSearchVectors mocks base method
+func (m *MockDao) ComputeSearchVectors(arg0 int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComputeSearchVectors", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ComputeSearchVectors indicates an expected call of ComputeSearchVectors
+func (mr *MockDaoMockRecorder) ComputeSearchVectors(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComputeSearchVectors", reflect.TypeOf((*MockDao)(nil).ComputeSearchVectors), arg0)
+}
+
+// CreateMany mocks base method
+func (m *MockDao) CreateMany(arg0 []*models.DecisionLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMany", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMany indicates an expected call of CreateMany
+func (mr *MockDaoMockRecorder) CreateMany(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMany", reflect.TypeOf((*MockDao)(nil).CreateMany), arg0)
+}
+
+// Delete mocks base method
+func (m *MockDao) Delete(arg0 *models.Filter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockDaoMockRecorder) Delete(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDao)(nil).Delete), arg0)
+}
+
+// DeleteBatchByPartitionID mocks base method
+func (m *MockDao) DeleteBatchByPartitionID(arg0 string, arg1 int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBatchByPartitionID", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBatchByPartitionID indicates an expected call of DeleteBatchByPartitionID
+func (mr *MockDaoMockRecorder) DeleteBatchByPartitionID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBatchByPartitionID", reflect.TypeOf((*MockDao)(nil).DeleteBatchByPartitionID), arg0, arg1)
+}
+
+// FindByID mocks base method
+func (m *MockDao) FindByID(arg0 string, arg1 *models.Projection) (*models.DecisionLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.DecisionLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID
+func (mr *MockDaoMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockDao)(nil).FindByID), arg0, arg1)
+}
+
+// FindOneByDecisionID mocks base method
+func (m *MockDao) FindOneByDecisionID(arg0 string, arg1 *models.Projection) (*models.DecisionLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByDecisionID", arg0, arg1)
+	ret0, _ := ret[0].(*models.DecisionLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByDecisionID indicates an expected call of FindOneByDecisionID
+func (mr *MockDaoMockRecorder) FindOneByDecisionID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByDecisionID", reflect.TypeOf((*MockDao)(nil).FindOneByDecisionID), arg0, arg1)
+}
+
+// FindOutdatedExtractions mocks base method
+func (m *MockDao) FindOutdatedExtractions(arg0, arg1 int) ([]*models.DecisionLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOutdatedExtractions", arg0, arg1)
+	ret0, _ := ret[0].([]*models.DecisionLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOutdatedExtractions indicates an expected call of FindOutdatedExtractions
+func (mr *MockDaoMockRecorder) FindOutdatedExtractions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOutdatedExtractions", reflect.TypeOf((*MockDao)(nil).FindOutdatedExtractions), arg0, arg1)
+}
+
+// GetAllPaginated mocks base method
+func (m *MockDao) GetAllPaginated(arg0 *pagination.PageInput, arg1 *models.SortOrder, arg2 *models.Filter, arg3 *models.Projection, arg4 *string) ([]*models.DecisionLog, *pagination.PageOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPaginated", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*models.DecisionLog)
+	ret1, _ := ret[1].(*pagination.PageOutput)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAllPaginated indicates an expected call of GetAllPaginated
+func (mr *MockDaoMockRecorder) GetAllPaginated(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPaginated", reflect.TypeOf((*MockDao)(nil).GetAllPaginated), arg0, arg1, arg2, arg3, arg4)
+}
+
+// MigrateDB mocks base method
+func (m *MockDao) MigrateDB() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateDB")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateDB indicates an expected call of MigrateDB
+func (mr *MockDaoMockRecorder) MigrateDB() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateDB", reflect.TypeOf((*MockDao)(nil).MigrateDB))
+}
+
+// UpdateExtractedFields mocks base method
+func (m *MockDao) UpdateExtractedFields(arg0 []*models.DecisionLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExtractedFields", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateExtractedFields indicates an expected call of UpdateExtractedFields
+func (mr *MockDaoMockRecorder) UpdateExtractedFields(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExtractedFields", reflect.TypeOf((*MockDao)(nil).UpdateExtractedFields), arg0)
+}
@@ -9,10 +9,23 @@ import (
 
 type DecisionLog struct {
 	database.Base
-	DecisionID      string `gorm:"unique_index"`
-	Path            string
-	RequestedBy     string
-	Timestamp       time.Time
-	OriginalMessage datatypes.JSON
-	PartitionID     string `gorm:"index"`
+	DecisionID           string `gorm:"uniqueIndex"`
+	Path                 string
+	RequestedBy          string
+	Timestamp            time.Time
+	OriginalMessage      datatypes.JSON
+	PartitionID          string `gorm:"index"`
+	Result               datatypes.JSON
+	Input                datatypes.JSON
+	InstanceID           *string `gorm:"index"`
+	Bundles              datatypes.JSON
+	BundleRevisions      *string `gorm:"index"`
+	Metrics              datatypes.JSON
+	TimerServerHandlerNs *int64 `gorm:"index"`
+	TimerRegoQueryEvalNs *int64
+	ReqID                *int64
+	Erased               datatypes.JSON
+	Masked               datatypes.JSON
+	Outcome              string `gorm:"index"`
+	ExtractionVersion    int    `gorm:"index;default:0"`
 }
@@ -2,6 +2,7 @@ package daos
 
 import (
 	"errors"
+	"fmt"
 
 	daosmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/daos/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
@@ -9,8 +10,65 @@ import (
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// Maximum number of rows inserted in one INSERT statement.
+const insertBatchSize = 500
+
+// Columns computed from original message.
+var extractedColumns = []string{
+	"result",
+	"input",
+	"instance_id",
+	"bundles",
+	"bundle_revisions",
+	"metrics",
+	"timer_server_handler_ns",
+	"timer_rego_query_eval_ns",
+	"req_id",
+	"erased",
+	"masked",
+	"outcome",
+	"extraction_version",
+}
+
+// SQL query removing search vector column when it has been created as a generated column.
+const dropGeneratedSearchVectorSQL = `DO $$ BEGIN
+IF EXISTS (
+	SELECT 1 FROM information_schema.columns
+	WHERE table_name = 'decision_logs' AND column_name = 'search_vector' AND is_generated = 'ALWAYS'
+) THEN
+	ALTER TABLE decision_logs DROP COLUMN search_vector;
+END IF;
+END $$`
+
+// searchVectorSQL will return SQL expression computing search vector of a decision log row.
+// Input values are weighted before result values and path.
+func searchVectorSQL(row string) string {
+	return fmt.Sprintf(
+		"setweight(jsonb_to_tsvector('simple', COALESCE(%[1]s.original_message -> 'input', 'null'::jsonb), '[\"string\", \"numeric\"]'), 'A') || "+
+			"setweight(jsonb_to_tsvector('simple', COALESCE(%[1]s.original_message -> 'result', 'null'::jsonb), '[\"string\", \"numeric\"]'), 'B') || "+
+			"setweight(to_tsvector('simple', replace(COALESCE(%[1]s.path, ''), '/', ' ')), 'C')",
+		row,
+	)
+}
+
+// Column containing full text search rank.
+const searchRankColumn = "search_rank"
+
+// searchResult is a decision log with its full text search rank.
+// Rank is needed to build pagination cursors when results are sorted by relevance.
+type searchResult struct {
+	daosmodels.DecisionLog
+	SearchRank float64
+}
+
+// TableName will return decision logs table name.
+func (searchResult) TableName() string {
+	return "decision_logs"
+}
+
 type service struct {
 	db database.DB
 }
@@ -18,10 +76,132 @@ type service struct {
 func (s *service) MigrateDB() error {
 	// Get gorm database
 	gdb := s.db.GetGormDB()
+	// Get migrator
+	migrator := gdb.Migrator()
+
+	// Check if unique index on decision id must be created on an existing table
+	if migrator.HasTable(&daosmodels.DecisionLog{}) && !migrator.HasIndex(&daosmodels.DecisionLog{}, "DecisionID") {
+		// Remove duplicated decision logs in order to be able to create the unique index
+		// Only the oldest one is kept
+		err := gdb.Exec(
+			"DELETE FROM decision_logs a USING decision_logs b " +
+				"WHERE a.decision_id = b.decision_id AND (a.created_at > b.created_at OR (a.created_at = b.created_at AND a.id > b.id))",
+		).Error
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
 	// Migrate
 	err := gdb.AutoMigrate(&daosmodels.DecisionLog{})
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Create GIN index on original message in order to filter on JSON values
+	err = gdb.Exec(
+		"CREATE INDEX IF NOT EXISTS idx_decision_logs_original_message ON decision_logs USING GIN (original_message jsonb_path_ops)",
+	).Error
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Create index matching default sort in order to seek pages quickly in a partition
+	err = gdb.Exec(
+		"CREATE INDEX IF NOT EXISTS idx_decision_logs_partition_created_at_id ON decision_logs (partition_id, created_at DESC, id DESC)",
+	).Error
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Drop search vector column generated by older versions
+	// Generated column rewrites table when added and cannot be set by a trigger
+	err = gdb.Exec(dropGeneratedSearchVectorSQL).Error
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Create search vector column
+	// Column is added without default in order to not rewrite table
+	// Existing rows are computed in background with ComputeSearchVectors
+	err = gdb.Exec("ALTER TABLE decision_logs ADD COLUMN IF NOT EXISTS search_vector tsvector").Error
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Create trigger maintaining search vector on new and updated rows
+	err = gdb.Transaction(func(tx *gorm.DB) error {
+		// Create function
+		err := tx.Exec(
+			"CREATE OR REPLACE FUNCTION decision_logs_search_vector() RETURNS trigger AS $$ BEGIN " +
+				"NEW.search_vector := " + searchVectorSQL("NEW") + "; RETURN NEW; " +
+				"END $$ LANGUAGE plpgsql",
+		).Error
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Remove trigger if it exists
+		// Create trigger doesn't support "IF NOT EXISTS" before PostgreSQL 14
+		err = tx.Exec("DROP TRIGGER IF EXISTS decision_logs_search_vector ON decision_logs").Error
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Create trigger
+		return tx.Exec(
+			"CREATE TRIGGER decision_logs_search_vector BEFORE INSERT OR UPDATE OF original_message, path " +
+				"ON decision_logs FOR EACH ROW EXECUTE PROCEDURE decision_logs_search_vector()",
+		).Error
+	})
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Create partial index in order to find quickly decision logs without search vector
+	err = gdb.Exec(
+		"CREATE INDEX IF NOT EXISTS idx_decision_logs_search_vector_missing ON decision_logs (id) WHERE search_vector IS NULL",
+	).Error
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Create GIN index on search vector
+	return gdb.Exec(
+		"CREATE INDEX IF NOT EXISTS idx_decision_logs_search_vector ON decision_logs USING GIN (search_vector)",
+	).Error
+}
+
+func (s *service) ComputeSearchVectors(limit int) (int64, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Select ids of batch
+	sub := gdb.Unscoped().
+		Model(&daosmodels.DecisionLog{}).
+		Select("id").
+		Where("search_vector IS NULL").
+		Limit(limit)
+	// Compute search vector
+	res := gdb.Unscoped().
+		Model(&daosmodels.DecisionLog{}).
+		Where("id IN (?)", sub).
+		UpdateColumn("search_vector", gorm.Expr(searchVectorSQL("decision_logs")))
+	// Check error
+	if res.Error != nil {
+		return 0, res.Error
+	}
 
-	return err
+	return res.RowsAffected, nil
 }
 
 func (s *service) Delete(filter *models.Filter) error {
@@ -37,6 +217,25 @@ func (s *service) Delete(filter *models.Filter) error {
 	return db.Unscoped().Delete(&models.DecisionLog{}).Error
 }
 
+func (s *service) DeleteBatchByPartitionID(partitionID string, limit int) (int64, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Select ids of batch
+	sub := gdb.Unscoped().
+		Model(&daosmodels.DecisionLog{}).
+		Select("id").
+		Where("partition_id = ?", partitionID).
+		Limit(limit)
+	// Delete batch
+	res := gdb.Unscoped().Where("id IN (?)", sub).Delete(&daosmodels.DecisionLog{})
+	// Check error
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return res.RowsAffected, nil
+}
+
 func (s *service) FindByID(id string, projection *models.Projection) (*models.DecisionLog, error) {
 	// Get gorm database
 	gdb := s.db.GetGormDB()
@@ -111,15 +310,105 @@ func (s *service) FindOneByDecisionID(did string, projection *models.Projection)
 	return mres, nil
 }
 
-func (s *service) Save(ins *models.DecisionLog) error {
+func (s *service) CreateMany(list []*models.DecisionLog) error {
+	// Check if there is something to insert
+	if len(list) == 0 {
+		return nil
+	}
+
 	// Get gorm database
 	gdb := s.db.GetGormDB()
-	// Transform object
-	input := toDao(ins)
-	// Save
-	res := gdb.Save(input)
+	// Transform objects
+	input := make([]*daosmodels.DecisionLog, 0, len(list))
+	// Loop over list
+	for _, it := range list {
+		// Map
+		r, err := toDao(it)
+		// Check error
+		if err != nil {
+			return err
+		}
+		// Append
+		input = append(input, r)
+	}
 
-	return res.Error
+	// Insert everything in one transaction in order to have all or nothing
+	return gdb.Transaction(func(tx *gorm.DB) error {
+		// Ignore decision logs already saved in order to be idempotent when OPA retries an upload
+		return tx.
+			Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "decision_id"}}, DoNothing: true}).
+			CreateInBatches(input, insertBatchSize).
+			Error
+	})
+}
+
+func (s *service) FindOutdatedExtractions(version, limit int) ([]*models.DecisionLog, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Create result
+	dres := make([]*daosmodels.DecisionLog, 0)
+
+	// Find decision logs extracted with an older version
+	err := gdb.
+		Select("id", "original_message", "partition_id", "extraction_version").
+		Where("extraction_version IS NULL OR extraction_version < ?", version).
+		Order("id").
+		Limit(limit).
+		Find(&dres).
+		Error
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Result
+	res := make([]*models.DecisionLog, 0, len(dres))
+	// Loop over list
+	for _, it := range dres {
+		// Map
+		r, err := fromDao(it)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Append
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+func (s *service) UpdateExtractedFields(list []*models.DecisionLog) error {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+
+	// Update everything in one transaction
+	return gdb.Transaction(func(tx *gorm.DB) error {
+		// Loop over list
+		for _, it := range list {
+			// Map
+			r, err := toDao(it)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			// Update only extracted columns
+			// Select is used in order to save empty values too
+			err = tx.
+				Model(&daosmodels.DecisionLog{}).
+				Where("id = ?", r.ID).
+				Select(extractedColumns).
+				Updates(r).
+				Error
+			// Check error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 }
 
 func (s *service) GetAllPaginated(
@@ -127,19 +416,42 @@ func (s *service) GetAllPaginated(
 	sort *models.SortOrder,
 	filter *models.Filter,
 	projection *models.Projection,
+	search *string,
 ) ([]*models.DecisionLog, *pagination.PageOutput, error) {
 	// Get gorm db
 	db := s.db.GetGormDB()
 	// result
-	dres := make([]*daosmodels.DecisionLog, 0)
-	// Find todos
-	pageOut, err := pagination.Paging(&dres, &pagination.PagingOptions{
+	dres := make([]*searchResult, 0)
+	// Create paging options
+	opts := &pagination.PagingOptions{
 		DB:         db,
 		Filter:     filter,
 		PageInput:  page,
 		Projection: projection,
 		Sort:       sort,
-	})
+	}
+
+	// Check if full text search is requested
+	if search != nil && *search != "" {
+		opts.ExtraFunc = func(tx *gorm.DB) (*gorm.DB, error) {
+			// Search and compute rank in a subquery in order to sort on it
+			sub := db.
+				Model(&daosmodels.DecisionLog{}).
+				Select("*, ts_rank(search_vector, websearch_to_tsquery('simple', ?)) AS search_rank", *search).
+				Where("search_vector @@ websearch_to_tsquery('simple', ?)", *search)
+
+			// Use subquery as table
+			return tx.Table("(?) AS decision_logs", sub), nil
+		}
+		// Sort by rank only when no sort is given
+		opts.DefaultSort = []*common.SortColumn{
+			{Column: searchRankColumn, Desc: true},
+			{Column: "created_at", Desc: true},
+		}
+	}
+
+	// Find decision logs
+	pageOut, err := pagination.Paging(&dres, opts)
 	// Check error
 	if err != nil {
 		return nil, nil, err
@@ -151,7 +463,7 @@ func (s *service) GetAllPaginated(
 	for i := 0; i < len(dres); i++ {
 		it := dres[i]
 		// Map
-		r, err := fromDao(it)
+		r, err := fromDao(&it.DecisionLog)
 		// Check error
 		if err != nil {
 			return nil, nil, err
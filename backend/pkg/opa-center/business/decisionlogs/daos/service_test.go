@@ -0,0 +1,104 @@
+//+build unit
+
+package daos
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newMockedService will create a dao service on a mocked database.
+func newMockedService(t *testing.T) (*service, sqlmock.Sqlmock, func()) {
+	ctrl := gomock.NewController(t)
+
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{Logger: logger.Discard})
+	assert.NoError(t, err)
+
+	dbMock := mocks.NewMockDB(ctrl)
+	dbMock.EXPECT().GetGormDB().Return(db).AnyTimes()
+
+	return &service{db: dbMock}, mock, func() {
+		assert.NoError(t, mock.ExpectationsWereMet())
+		ctrl.Finish()
+	}
+}
+
+func Test_searchVectorSQL(t *testing.T) {
+	got := searchVectorSQL("NEW")
+	assert.Contains(t, got, `jsonb_to_tsvector('simple', COALESCE(NEW.original_message -> 'input', 'null'::jsonb), '["string", "numeric"]'), 'A')`)
+	assert.Contains(t, got, `jsonb_to_tsvector('simple', COALESCE(NEW.original_message -> 'result', 'null'::jsonb), '["string", "numeric"]'), 'B')`)
+	assert.Contains(t, got, `to_tsvector('simple', replace(COALESCE(NEW.path, ''), '/', ' ')), 'C')`)
+}
+
+func Test_service_ComputeSearchVectors(t *testing.T) {
+	s, mock, finish := newMockedService(t)
+	defer finish()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "decision_logs" SET "search_vector"=` + searchVectorSQL("decision_logs") +
+			` WHERE id IN (SELECT "id" FROM "decision_logs" WHERE search_vector IS NULL LIMIT 10)`,
+	)).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	got, err := s.ComputeSearchVectors(10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), got)
+}
+
+func Test_service_GetAllPaginated_search(t *testing.T) {
+	s, mock, finish := newMockedService(t)
+	defer finish()
+
+	date := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	search := "fake user"
+
+	// Search must filter on search vector and sort by rank by default
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM (SELECT *, ts_rank(search_vector, websearch_to_tsquery('simple', $1)) AS search_rank `+
+			`FROM "decision_logs" WHERE (search_vector @@ websearch_to_tsquery('simple', $2)) AND "decision_logs"."deleted_at" IS NULL) AS decision_logs `+
+			`WHERE "decision_logs"."deleted_at" IS NULL ORDER BY search_rank DESC,created_at DESC,id DESC LIMIT 3`,
+	)).
+		WithArgs(search, search).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "created_at", "decision_id", "original_message", "search_rank"}).
+				AddRow("1", date, "did", `{}`, 0.5),
+		)
+
+	got, pageOut, err := s.GetAllPaginated(&pagination.PageInput{Limit: 2, WithoutCount: true}, nil, nil, nil, &search)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, "did", got[0].DecisionID)
+	}
+	assert.False(t, pageOut.HasNext)
+}
+
+func Test_service_GetAllPaginated_emptySearch(t *testing.T) {
+	s, mock, finish := newMockedService(t)
+	defer finish()
+
+	// Empty search must not use search vector
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "decision_logs" WHERE "decision_logs"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC LIMIT 3`,
+	)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	search := ""
+	got, _, err := s.GetAllPaginated(&pagination.PageInput{Limit: 2, WithoutCount: true}, nil, nil, nil, &search)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
@@ -0,0 +1,330 @@
+package decisionlogs
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+)
+
+// Separator used between bundle revisions.
+const bundleRevisionsSeparator = ","
+
+// OPA metrics keys.
+const (
+	timerServerHandlerMetricKey = "timer_server_handler_ns"
+	timerRegoQueryEvalMetricKey = "timer_rego_query_eval_ns"
+)
+
+// extractFields will fill decision log fields computed from OPA message.
+// Optional fields with an invalid type are skipped and returned as warnings
+// because they are still available in the original message.
+func extractFields(dl *models.DecisionLog, data map[string]interface{}, rule *pmodels.OutcomeRule) ([]error, error) {
+	var err error
+	// Create list of skipped fields
+	skipped := make([]error, 0)
+
+	// Add outcome
+	dl.Outcome = computeOutcome(data["result"], rule)
+
+	// Add result
+	dl.Result, err = getJSONField(data, "result")
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Add input
+	dl.Input, err = getJSONField(data, "input")
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Add instance id
+	err = extractInstanceID(dl, data)
+	// Check error
+	if err != nil {
+		skipped = append(skipped, err)
+	}
+
+	// Add bundles
+	err = extractBundles(dl, data)
+	// Check error
+	if err != nil {
+		skipped = append(skipped, err)
+	}
+
+	// Add metrics
+	skipped = append(skipped, extractMetrics(dl, data)...)
+
+	// Add request id
+	reqID, err := getInt64Field(data, "req_id")
+	// Check error
+	if err != nil {
+		skipped = append(skipped, err)
+	} else {
+		dl.ReqID = reqID
+	}
+	// Add erased
+	erased, err := getStringListField(data, "erased")
+	// Check error
+	if err != nil {
+		skipped = append(skipped, err)
+	} else {
+		dl.Erased = erased
+	}
+	// Add masked
+	masked, err := getStringListField(data, "masked")
+	// Check error
+	if err != nil {
+		skipped = append(skipped, err)
+	} else {
+		dl.Masked = masked
+	}
+
+	// Save extraction version
+	dl.ExtractionVersion = models.ExtractionVersion
+
+	return skipped, nil
+}
+
+// extractInstanceID will fill instance id from the labels object.
+func extractInstanceID(dl *models.DecisionLog, data map[string]interface{}) error {
+	// Get labels
+	labels, err := getObjectField(data, "labels")
+	// Check error
+	if err != nil {
+		return err
+	}
+	// Get instance id
+	id, err := getStringField(labels, "id")
+	// Check error
+	if err != nil {
+		return fmt.Errorf("labels.%w", err)
+	}
+	// Check if id is present
+	if id != "" {
+		dl.InstanceID = &id
+	}
+
+	return nil
+}
+
+// computeOutcome will classify decision result with outcome rule.
+func computeOutcome(result interface{}, rule *pmodels.OutcomeRule) models.OutcomeEnum {
+	// Evaluate rule
+	allowed, defined := rule.Evaluate(result)
+	// Check if outcome is undefined
+	if !defined {
+		return models.OutcomeEnumUndefined
+	}
+	// Check if decision is allowed
+	if allowed {
+		return models.OutcomeEnumAllowed
+	}
+
+	return models.OutcomeEnumDenied
+}
+
+// extractBundles will fill bundles and bundle revisions from the bundles object.
+// Legacy revision field is used when bundles aren't present.
+func extractBundles(dl *models.DecisionLog, data map[string]interface{}) error {
+	// Get bundles
+	bundles, err := getObjectField(data, "bundles")
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Check if bundles aren't present
+	if bundles == nil {
+		// Get legacy revision
+		rev, err := getStringField(data, "revision")
+		// Check error
+		if err != nil {
+			return err
+		}
+		// Check if revision is present
+		if rev != "" {
+			dl.BundleRevisions = &rev
+		}
+
+		return nil
+	}
+
+	// Create list
+	list := make([]*models.Bundle, 0, len(bundles))
+	// Loop over bundles
+	for name := range bundles {
+		// Get bundle
+		b, err := getObjectField(bundles, name)
+		// Check error
+		if err != nil {
+			return fmt.Errorf("bundles.%w", err)
+		}
+		// Get revision
+		rev, err := getStringField(b, "revision")
+		// Check error
+		if err != nil {
+			return fmt.Errorf("bundles.%s.%w", name, err)
+		}
+		// Append
+		list = append(list, &models.Bundle{Name: name, Revision: rev})
+	}
+
+	// Sort by name in order to have a stable result
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	// Save bundles only when they are all valid
+	dl.Bundles = list
+
+	// Create revisions list
+	revs := make([]string, 0, len(list))
+	// Loop over bundles
+	for _, b := range list {
+		revs = append(revs, b.Revision)
+	}
+	// Check if there is at least one bundle
+	if len(revs) != 0 {
+		r := strings.Join(revs, bundleRevisionsSeparator)
+		dl.BundleRevisions = &r
+	}
+
+	return nil
+}
+
+// extractMetrics will fill metrics and timers.
+// Errors are returned for each invalid field.
+func extractMetrics(dl *models.DecisionLog, data map[string]interface{}) []error {
+	// Get metrics
+	metrics, err := getObjectField(data, "metrics")
+	// Check error
+	if err != nil {
+		return []error{err}
+	}
+	// Check if metrics aren't present
+	if metrics == nil {
+		return nil
+	}
+
+	// Add metrics
+	dl.Metrics, err = getJSONField(data, "metrics")
+	// Check error
+	if err != nil {
+		return []error{err}
+	}
+
+	// Create result
+	var res []error
+	// Add server handler timer
+	timer, err := getInt64Field(metrics, timerServerHandlerMetricKey)
+	// Check error
+	if err != nil {
+		res = append(res, fmt.Errorf("metrics.%w", err))
+	} else {
+		dl.TimerServerHandlerNs = timer
+	}
+	// Add query evaluation timer
+	timer, err = getInt64Field(metrics, timerRegoQueryEvalMetricKey)
+	// Check error
+	if err != nil {
+		res = append(res, fmt.Errorf("metrics.%w", err))
+	} else {
+		dl.TimerRegoQueryEvalNs = timer
+	}
+
+	return res
+}
+
+// getJSONField will return the JSON value of a field or nil if it isn't present.
+func getJSONField(data map[string]interface{}, key string) (*string, error) {
+	// Get value
+	v, ok := data[key]
+	// Check if field exists
+	if !ok {
+		return nil, nil
+	}
+
+	// Marshal
+	bb, err := json.Marshal(v)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Transform in string
+	s := string(bb)
+
+	return &s, nil
+}
+
+// getObjectField will return the value of an object field or an error if it isn't an object.
+func getObjectField(data map[string]interface{}, key string) (map[string]interface{}, error) {
+	// Check if field exists
+	if data[key] == nil {
+		return nil, nil
+	}
+
+	// Cast value
+	v, ok := data[key].(map[string]interface{})
+	// Check if cast was a success
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object", key)
+	}
+
+	return v, nil
+}
+
+// getInt64Field will return the value of an integer field or an error if it isn't an integer.
+func getInt64Field(data map[string]interface{}, key string) (*int64, error) {
+	// Check if field exists
+	if data[key] == nil {
+		return nil, nil
+	}
+
+	// Cast value
+	// JSON numbers are decoded as float64
+	v, ok := data[key].(float64)
+	// Check if cast was a success
+	if !ok || v != float64(int64(v)) {
+		return nil, fmt.Errorf("%s must be an integer", key)
+	}
+
+	// Transform
+	res := int64(v)
+
+	return &res, nil
+}
+
+// getStringListField will return the value of a string list field or an error if it isn't a string list.
+func getStringListField(data map[string]interface{}, key string) ([]string, error) {
+	// Check if field exists
+	if data[key] == nil {
+		return nil, nil
+	}
+
+	// Cast value
+	list, ok := data[key].([]interface{})
+	// Check if cast was a success
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list of strings", key)
+	}
+
+	// Create result
+	res := make([]string, 0, len(list))
+	// Loop over list
+	for _, it := range list {
+		// Cast value
+		v, ok := it.(string)
+		// Check if cast was a success
+		if !ok {
+			return nil, fmt.Errorf("%s must be a list of strings", key)
+		}
+		// Append
+		res = append(res, v)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,182 @@
+//+build unit
+
+package decisionlogs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_extractFields(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+	int64Ptr := func(i int64) *int64 { return &i }
+
+	tests := []struct {
+		name        string
+		message     string
+		want        *models.DecisionLog
+		wantSkipped int
+	}{
+		{
+			name:    "empty message",
+			message: `{}`,
+			want:    &models.DecisionLog{Outcome: models.OutcomeEnumUndefined, ExtractionVersion: models.ExtractionVersion},
+		},
+		{
+			name: "full message",
+			message: `{
+				"result": false,
+				"input": {"user": "fake"},
+				"labels": {"id": "instance", "version": "0.26.0"},
+				"bundles": {"b2": {"revision": "r2"}, "b1": {"revision": "r1"}},
+				"metrics": {"timer_rego_query_eval_ns": 20, "timer_server_handler_ns": 30},
+				"req_id": 4,
+				"erased": ["/input/password"],
+				"masked": []
+			}`,
+			want: &models.DecisionLog{
+				Result:               strPtr(`false`),
+				Input:                strPtr(`{"user":"fake"}`),
+				InstanceID:           strPtr("instance"),
+				Bundles:              []*models.Bundle{{Name: "b1", Revision: "r1"}, {Name: "b2", Revision: "r2"}},
+				BundleRevisions:      strPtr("r1,r2"),
+				Metrics:              strPtr(`{"timer_rego_query_eval_ns":20,"timer_server_handler_ns":30}`),
+				TimerServerHandlerNs: int64Ptr(30),
+				TimerRegoQueryEvalNs: int64Ptr(20),
+				ReqID:                int64Ptr(4),
+				Erased:               []string{"/input/password"},
+				Masked:               []string{},
+				Outcome:              models.OutcomeEnumDenied,
+				ExtractionVersion:    models.ExtractionVersion,
+			},
+		},
+		{
+			name:    "null result",
+			message: `{"result": null}`,
+			want:    &models.DecisionLog{Result: strPtr(`null`), Outcome: models.OutcomeEnumUndefined, ExtractionVersion: models.ExtractionVersion},
+		},
+		{
+			name:    "legacy revision",
+			message: `{"revision": "r1"}`,
+			want:    &models.DecisionLog{BundleRevisions: strPtr("r1"), Outcome: models.OutcomeEnumUndefined, ExtractionVersion: models.ExtractionVersion},
+		},
+		{
+			name:        "invalid labels are skipped",
+			message:     `{"labels": "fake", "req_id": 4}`,
+			want:        &models.DecisionLog{ReqID: int64Ptr(4), Outcome: models.OutcomeEnumUndefined, ExtractionVersion: models.ExtractionVersion},
+			wantSkipped: 1,
+		},
+		{
+			name:        "invalid instance id is skipped",
+			message:     `{"labels": {"id": 1}, "req_id": 4}`,
+			want:        &models.DecisionLog{ReqID: int64Ptr(4), Outcome: models.OutcomeEnumUndefined, ExtractionVersion: models.ExtractionVersion},
+			wantSkipped: 1,
+		},
+		{
+			name:        "invalid bundle is skipped",
+			message:     `{"bundles": {"b1": {"revision": "r1"}, "b2": "r2"}}`,
+			want:        &models.DecisionLog{Outcome: models.OutcomeEnumUndefined, ExtractionVersion: models.ExtractionVersion},
+			wantSkipped: 1,
+		},
+		{
+			name:    "invalid metrics object is skipped",
+			message: `{"metrics": "fake", "labels": {"id": "instance"}}`,
+			want: &models.DecisionLog{
+				InstanceID:        strPtr("instance"),
+				Outcome:           models.OutcomeEnumUndefined,
+				ExtractionVersion: models.ExtractionVersion,
+			},
+			wantSkipped: 1,
+		},
+		{
+			name:    "invalid metric is skipped",
+			message: `{"metrics": {"timer_server_handler_ns": "fake", "timer_rego_query_eval_ns": 20}}`,
+			want: &models.DecisionLog{
+				Metrics:              strPtr(`{"timer_rego_query_eval_ns":20,"timer_server_handler_ns":"fake"}`),
+				TimerRegoQueryEvalNs: int64Ptr(20),
+				Outcome:              models.OutcomeEnumUndefined,
+				ExtractionVersion:    models.ExtractionVersion,
+			},
+			wantSkipped: 1,
+		},
+		{
+			name:    "timer larger than 32 bits",
+			message: `{"metrics": {"timer_server_handler_ns": 5000000000}}`,
+			want: &models.DecisionLog{
+				Metrics:              strPtr(`{"timer_server_handler_ns":5000000000}`),
+				TimerServerHandlerNs: int64Ptr(5000000000),
+				Outcome:              models.OutcomeEnumUndefined,
+				ExtractionVersion:    models.ExtractionVersion,
+			},
+		},
+		{
+			name:        "invalid request id is skipped",
+			message:     `{"req_id": 1.5}`,
+			want:        &models.DecisionLog{Outcome: models.OutcomeEnumUndefined, ExtractionVersion: models.ExtractionVersion},
+			wantSkipped: 1,
+		},
+		{
+			name:        "invalid erased and masked are skipped",
+			message:     `{"erased": [1], "masked": "fake"}`,
+			want:        &models.DecisionLog{Outcome: models.OutcomeEnumUndefined, ExtractionVersion: models.ExtractionVersion},
+			wantSkipped: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data map[string]interface{}
+			err := json.Unmarshal([]byte(tt.message), &data)
+			assert.NoError(t, err)
+
+			rule, err := pmodels.ParseOutcomeRule("")
+			assert.NoError(t, err)
+
+			got := &models.DecisionLog{}
+			skipped, err := extractFields(got, data, rule)
+			assert.NoError(t, err)
+			assert.Len(t, skipped, tt.wantSkipped)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_computeOutcome(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   string
+		result interface{}
+		want   models.OutcomeEnum
+	}{
+		{
+			name:   "allowed",
+			result: true,
+			want:   models.OutcomeEnumAllowed,
+		},
+		{
+			name:   "denied",
+			result: false,
+			want:   models.OutcomeEnumDenied,
+		},
+		{
+			name: "undefined",
+			want: models.OutcomeEnumUndefined,
+		},
+		{
+			name:   "negated pointer",
+			rule:   "!/deny",
+			result: map[string]interface{}{"deny": true},
+			want:   models.OutcomeEnumDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := pmodels.ParseOutcomeRule(tt.rule)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, computeOutcome(tt.result, rule))
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs (interfaces: Service)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	pagination "github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
+	log "github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+	reflect "reflect"
+	time "time"
+)
+
+// MockService is a mock of Service interface
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// FindByIDOrDecisionID mocks base method
+func (m *MockService) FindByIDOrDecisionID(arg0 context.Context, arg1, arg2 *string, arg3 *models.Projection) (*models.DecisionLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDOrDecisionID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.DecisionLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDOrDecisionID indicates an expected call of FindByIDOrDecisionID
+func (mr *MockServiceMockRecorder) FindByIDOrDecisionID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDOrDecisionID", reflect.TypeOf((*MockService)(nil).FindByIDOrDecisionID), arg0, arg1, arg2, arg3)
+}
+
+// GetAllPaginated mocks base method
+func (m *MockService) GetAllPaginated(arg0 context.Context, arg1 string, arg2 *pagination.PageInput, arg3 *models.SortOrder, arg4 *models.Filter, arg5 *models.Projection, arg6, arg7 *string) ([]*models.DecisionLog, *pagination.PageOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPaginated", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	ret0, _ := ret[0].([]*models.DecisionLog)
+	ret1, _ := ret[1].(*pagination.PageOutput)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAllPaginated indicates an expected call of GetAllPaginated
+func (mr *MockServiceMockRecorder) GetAllPaginated(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPaginated", reflect.TypeOf((*MockService)(nil).GetAllPaginated), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
+
+// GetAllPaginatedInPartitions mocks base method
+func (m *MockService) GetAllPaginatedInPartitions(arg0 context.Context, arg1, arg2 *string, arg3 *pagination.PageInput, arg4 *models.SortOrder, arg5 *models.Filter, arg6 *models.Projection, arg7, arg8 *string) ([]*models.DecisionLog, *pagination.PageOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPaginatedInPartitions", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+	ret0, _ := ret[0].([]*models.DecisionLog)
+	ret1, _ := ret[1].(*pagination.PageOutput)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAllPaginatedInPartitions indicates an expected call of GetAllPaginatedInPartitions
+func (mr *MockServiceMockRecorder) GetAllPaginatedInPartitions(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPaginatedInPartitions", reflect.TypeOf((*MockService)(nil).GetAllPaginatedInPartitions), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+}
+
+// ManageRetention mocks base method
+func (m *MockService) ManageRetention(arg0 log.Logger, arg1 time.Duration, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManageRetention", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ManageRetention indicates an expected call of ManageRetention
+func (mr *MockServiceMockRecorder) ManageRetention(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManageRetention", reflect.TypeOf((*MockService)(nil).ManageRetention), arg0, arg1, arg2)
+}
+
+// MigrateDB mocks base method
+func (m *MockService) MigrateDB(arg0 log.Logger) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateDB", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateDB indicates an expected call of MigrateDB
+func (mr *MockServiceMockRecorder) MigrateDB(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateDB", reflect.TypeOf((*MockService)(nil).MigrateDB), arg0)
+}
+
+// PurgePartition mocks base method
+func (m *MockService) PurgePartition(arg0 log.Logger, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgePartition", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgePartition indicates an expected call of PurgePartition
+func (mr *MockServiceMockRecorder) PurgePartition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgePartition", reflect.TypeOf((*MockService)(nil).PurgePartition), arg0, arg1)
+}
+
+// UnsecureCreate mocks base method
+func (m *MockService) UnsecureCreate(arg0 string, arg1 []map[string]interface{}) (*models.IngestionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsecureCreate", arg0, arg1)
+	ret0, _ := ret[0].(*models.IngestionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsecureCreate indicates an expected call of UnsecureCreate
+func (mr *MockServiceMockRecorder) UnsecureCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsecureCreate", reflect.TypeOf((*MockService)(nil).UnsecureCreate), arg0, arg1)
+}
@@ -1,17 +1,89 @@
 package models
 
 import (
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 )
 
+// ExtractionVersion is the current version of the fields extraction.
+// Decision logs stored with an older version will be extracted again in background at startup.
+const ExtractionVersion = 3
+
+// OutcomeEnum represents the outcome of a decision computed with the partition outcome rule.
+type OutcomeEnum string
+
+var (
+	OutcomeEnumAllowed   OutcomeEnum = "ALLOWED"
+	OutcomeEnumDenied    OutcomeEnum = "DENIED"
+	OutcomeEnumUndefined OutcomeEnum = "UNDEFINED"
+)
+
+var AllOutcomeEnum = []OutcomeEnum{
+	OutcomeEnumAllowed,
+	OutcomeEnumDenied,
+	OutcomeEnumUndefined,
+}
+
+func (e OutcomeEnum) IsValid() bool {
+	switch e {
+	case OutcomeEnumAllowed, OutcomeEnumDenied, OutcomeEnumUndefined:
+		return true
+	}
+
+	return false
+}
+
+func (e OutcomeEnum) String() string {
+	return string(e)
+}
+
+func (e *OutcomeEnum) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OutcomeEnum(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OutcomeEnum", str)
+	}
+
+	return nil
+}
+
+func (e OutcomeEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type DecisionLog struct {
-	ID              string
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
-	DecisionID      string    `validate:"required,max=255"`
-	Path            string    `validate:"required,max=255"`
-	RequestedBy     string    `validate:"required,max=255"`
-	Timestamp       time.Time `validate:"required"`
-	OriginalMessage string    `validate:"required"`
-	PartitionID     string
+	ID                   string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	DecisionID           string    `validate:"required,max=255"`
+	Path                 string    `validate:"required,max=255"`
+	RequestedBy          string    `validate:"required,max=255"`
+	Timestamp            time.Time `validate:"required"`
+	OriginalMessage      string    `validate:"required"`
+	PartitionID          string
+	Result               *string
+	Input                *string
+	InstanceID           *string `validate:"omitempty,max=255"`
+	Bundles              []*Bundle
+	BundleRevisions      *string
+	Metrics              *string
+	TimerServerHandlerNs *int64
+	TimerRegoQueryEvalNs *int64
+	ReqID                *int64
+	Erased               []string
+	Masked               []string
+	Outcome              OutcomeEnum
+	ExtractionVersion    int
+}
+
+// Bundle represents a bundle revision used for a decision.
+type Bundle struct {
+	Name     string
+	Revision string
 }
@@ -3,33 +3,59 @@ package models
 import "github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
 
 type SortOrder struct {
-	CreatedAt   *common.SortOrderEnum `dbfield:"created_at"`
-	UpdatedAt   *common.SortOrderEnum `dbfield:"updated_at"`
-	Path        *common.SortOrderEnum `dbfield:"path"`
-	RequestedBy *common.SortOrderEnum `dbfield:"requested_by"`
-	Timestamp   *common.SortOrderEnum `dbfield:"timestamp"`
+	CreatedAt            *common.SortOrderEnum `dbfield:"created_at"`
+	UpdatedAt            *common.SortOrderEnum `dbfield:"updated_at"`
+	Path                 *common.SortOrderEnum `dbfield:"path"`
+	RequestedBy          *common.SortOrderEnum `dbfield:"requested_by"`
+	Timestamp            *common.SortOrderEnum `dbfield:"timestamp"`
+	InstanceID           *common.SortOrderEnum `dbfield:"instance_id"`
+	BundleRevisions      *common.SortOrderEnum `dbfield:"bundle_revisions"`
+	TimerServerHandlerNs *common.SortOrderEnum `dbfield:"timer_server_handler_ns"`
+	TimerRegoQueryEvalNs *common.SortOrderEnum `dbfield:"timer_rego_query_eval_ns"`
+	ReqID                *common.SortOrderEnum `dbfield:"req_id"`
+	Outcome              *common.SortOrderEnum `dbfield:"outcome"`
 }
 
 type Filter struct {
-	AND         []*Filter
-	OR          []*Filter
-	CreatedAt   *common.DateFilter    `dbfield:"created_at"`
-	UpdatedAt   *common.DateFilter    `dbfield:"updated_at"`
-	DecisionID  *common.GenericFilter `dbfield:"decision_id"`
-	Path        *common.GenericFilter `dbfield:"path"`
-	RequestedBy *common.GenericFilter `dbfield:"requested_by"`
-	Timestamp   *common.DateFilter    `dbfield:"timestamp"`
-	PartitionID *common.GenericFilter `dbfield:"partition_id"`
+	AND                  []*Filter
+	OR                   []*Filter
+	CreatedAt            *common.DateFilter        `dbfield:"created_at"`
+	UpdatedAt            *common.DateFilter        `dbfield:"updated_at"`
+	DecisionID           *common.GenericFilter     `dbfield:"decision_id"`
+	Path                 *common.GenericFilter     `dbfield:"path"`
+	RequestedBy          *common.GenericFilter     `dbfield:"requested_by"`
+	Timestamp            *common.DateFilter        `dbfield:"timestamp"`
+	PartitionID          *common.GenericFilter     `dbfield:"partition_id"`
+	InstanceID           *common.GenericFilter     `dbfield:"instance_id"`
+	BundleRevisions      *common.GenericFilter     `dbfield:"bundle_revisions"`
+	TimerServerHandlerNs *common.GenericFilter     `dbfield:"timer_server_handler_ns"`
+	TimerRegoQueryEvalNs *common.GenericFilter     `dbfield:"timer_rego_query_eval_ns"`
+	ReqID                *common.GenericFilter     `dbfield:"req_id"`
+	Outcome              *common.GenericFilter     `dbfield:"outcome"`
+	Input                []*common.JSONFieldFilter `dbfield:"original_message" jsonfield:"input"`
+	Result               []*common.JSONFieldFilter `dbfield:"original_message" jsonfield:"result"`
 }
 
 type Projection struct {
-	ID              bool `dbfield:"id" graphqlfield:"id"`
-	CreatedAt       bool `dbfield:"created_at" graphqlfield:"createdAt"`
-	UpdatedAt       bool `dbfield:"updated_at" graphqlfield:"updatedAt"`
-	DecisionID      bool `dbfield:"decision_id" graphqlfield:"decisionId"`
-	Path            bool `dbfield:"path" graphqlfield:"path"`
-	RequestedBy     bool `dbfield:"requested_by" graphqlfield:"requestedBy"`
-	Timestamp       bool `dbfield:"timestamp" graphqlfield:"timestamp"`
-	OriginalMessage bool `dbfield:"original_message" graphqlfield:"originalMessage"`
-	PartitionID     bool `dbfield:"partition_id" graphqlfield:"partition"`
+	ID                   bool `dbfield:"id" graphqlfield:"id"`
+	CreatedAt            bool `dbfield:"created_at" graphqlfield:"createdAt"`
+	UpdatedAt            bool `dbfield:"updated_at" graphqlfield:"updatedAt"`
+	DecisionID           bool `dbfield:"decision_id" graphqlfield:"decisionId"`
+	Path                 bool `dbfield:"path" graphqlfield:"path"`
+	RequestedBy          bool `dbfield:"requested_by" graphqlfield:"requestedBy"`
+	Timestamp            bool `dbfield:"timestamp" graphqlfield:"timestamp"`
+	OriginalMessage      bool `dbfield:"original_message" graphqlfield:"originalMessage"`
+	PartitionID          bool `dbfield:"partition_id" graphqlfield:"partition"`
+	Result               bool `dbfield:"result" graphqlfield:"result"`
+	Input                bool `dbfield:"input" graphqlfield:"input"`
+	InstanceID           bool `dbfield:"instance_id" graphqlfield:"instanceId"`
+	Bundles              bool `dbfield:"bundles" graphqlfield:"bundles"`
+	BundleRevisions      bool `dbfield:"bundle_revisions" graphqlfield:"bundleRevisions"`
+	Metrics              bool `dbfield:"metrics" graphqlfield:"metrics"`
+	TimerServerHandlerNs bool `dbfield:"timer_server_handler_ns" graphqlfield:"timerServerHandlerNs"`
+	TimerRegoQueryEvalNs bool `dbfield:"timer_rego_query_eval_ns" graphqlfield:"timerRegoQueryEvalNs"`
+	ReqID                bool `dbfield:"req_id" graphqlfield:"reqId"`
+	Erased               bool `dbfield:"erased" graphqlfield:"erased"`
+	Masked               bool `dbfield:"masked" graphqlfield:"masked"`
+	Outcome              bool `dbfield:"outcome" graphqlfield:"outcome"`
 }
@@ -0,0 +1,13 @@
+package models
+
+// IngestionResult represents the result of a decision logs payload ingestion.
+type IngestionResult struct {
+	Accepted int              `json:"accepted"`
+	Rejected []*RejectedEntry `json:"rejected"`
+}
+
+// RejectedEntry represents an entry of a payload that cannot be stored.
+type RejectedEntry struct {
+	Index  int    `json:"index"`
+	Reason string `json:"reason"`
+}
@@ -4,14 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/pkg/errors"
-
 	"github.com/go-playground/validator/v10"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authorization"
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/daos"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
@@ -19,17 +22,222 @@ import (
 
 const mainAuthorizationPrefix = "decisionlogs"
 
+// Number of decision logs removed in one request when a partition is purged.
+const purgeBatchSize = 1000
+
+// Number of decision logs extracted again in one batch during migration.
+const extractionBatchSize = 500
+
+// Number of decision logs with search vector computed in one batch during migration.
+const searchVectorBatchSize = 1000
+
+// Pause between two migration batches in order to not overload database.
+const migrationBatchPause = 100 * time.Millisecond
+
+// Query value mappers in order to support outcome aliases like "deny".
+var queryValueMappers = map[string]common.QueryValueMapper{
+	"Outcome": func(value string) (string, error) {
+		// Check aliases
+		switch strings.ToLower(value) {
+		case "allow", "allowed":
+			return models.OutcomeEnumAllowed.String(), nil
+		case "deny", "denied":
+			return models.OutcomeEnumDenied.String(), nil
+		case "undefined":
+			return models.OutcomeEnumUndefined.String(), nil
+		default:
+			return "", fmt.Errorf("%s is not a valid outcome", value)
+		}
+	},
+}
+
 type service struct {
 	dao              daos.Dao
 	validator        *validator.Validate
 	partitionSvc     PartitionService
 	authorizationSvc authorization.Service
+	cfgManager       config.Manager
 }
 
 func (s *service) MigrateDB(systemLogger log.Logger) error {
 	systemLogger.Debug("Migrate database for Decision Logs")
 
-	return s.dao.MigrateDB()
+	// Migrate
+	err := s.dao.MigrateDB()
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Compute search vectors and extract fields of existing decision logs in background
+	// in order to not block startup on big tables
+	go s.runBackgroundMigrations(systemLogger)
+
+	return nil
+}
+
+// runBackgroundMigrations will run data migrations of existing decision logs and log errors.
+func (s *service) runBackgroundMigrations(systemLogger log.Logger) {
+	// Compute search vectors
+	err := s.migrateSearchVectors(systemLogger, migrationBatchPause)
+	// Check error
+	if err != nil {
+		systemLogger.Error(err)
+	}
+
+	// Extract fields
+	err = s.migrateExtractedFields(systemLogger, migrationBatchPause)
+	// Check error
+	if err != nil {
+		systemLogger.Error(err)
+	}
+}
+
+// migrateSearchVectors will compute full text search vectors of decision logs created before search support.
+// Decision logs are processed in batches with a pause between them.
+func (s *service) migrateSearchVectors(systemLogger log.Logger, pause time.Duration) error {
+	// Count migrated decision logs
+	var count int64
+
+	for {
+		// Compute a batch
+		updated, err := s.dao.ComputeSearchVectors(searchVectorBatchSize)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		count += updated
+
+		// Check if this was the last batch
+		if updated < searchVectorBatchSize {
+			break
+		}
+
+		// Wait before next batch
+		time.Sleep(pause)
+	}
+
+	// Check if decision logs have been migrated
+	if count != 0 {
+		systemLogger.Infof("Search vectors computed for %d existing decision logs", count)
+	}
+
+	return nil
+}
+
+// migrateExtractedFields will extract fields of decision logs saved with an older extraction version.
+// Decision logs are processed in batches with a pause between them.
+func (s *service) migrateExtractedFields(systemLogger log.Logger, pause time.Duration) error {
+	// Count migrated decision logs
+	count := 0
+	// Cache outcome rules by partition id
+	rules := map[string]*pmodels.OutcomeRule{}
+
+	for {
+		// Get a batch of outdated decision logs
+		list, err := s.dao.FindOutdatedExtractions(models.ExtractionVersion, extractionBatchSize)
+		// Check error
+		if err != nil {
+			return err
+		}
+		// Check if migration is finished
+		if len(list) == 0 {
+			break
+		}
+
+		// Loop over list
+		for _, dl := range list {
+			// Get outcome rule
+			rule, err := s.getOutcomeRule(rules, dl.PartitionID)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			// Parse original message
+			var data map[string]interface{}
+			// Unmarshal
+			err = json.Unmarshal([]byte(dl.OriginalMessage), &data)
+			// Check error
+			if err == nil {
+				// Extract fields
+				var skipped []error
+				skipped, err = extractFields(dl, data, rule)
+				// Loop over skipped fields
+				for _, e := range skipped {
+					systemLogger.Debugf("field skipped for decision log %s: %v", dl.ID, e)
+				}
+			}
+			// Check error
+			if err != nil {
+				// Ignore invalid decision logs in order to not block migration
+				systemLogger.Warnf("cannot extract fields of decision log %s: %v", dl.ID, err)
+			}
+
+			// Save extraction version even on error in order to not extract it again
+			dl.ExtractionVersion = models.ExtractionVersion
+		}
+
+		// Save
+		err = s.dao.UpdateExtractedFields(list)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		count += len(list)
+
+		// Check if this was the last batch
+		if len(list) < extractionBatchSize {
+			break
+		}
+
+		// Wait before next batch
+		time.Sleep(pause)
+	}
+
+	// Check if decision logs have been migrated
+	if count != 0 {
+		systemLogger.Infof("Fields extracted for %d existing decision logs", count)
+	}
+
+	return nil
+}
+
+// getOutcomeRule will return outcome rule of partition using cache.
+// Default rule is used if partition doesn't exist anymore.
+func (s *service) getOutcomeRule(cache map[string]*pmodels.OutcomeRule, partitionID string) (*pmodels.OutcomeRule, error) {
+	// Check cache
+	if rule, ok := cache[partitionID]; ok {
+		return rule, nil
+	}
+
+	// Find partition
+	partition, err := s.partitionSvc.UnsecureFindByID(partitionID)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get rule
+	ruleStr := ""
+	// Check if partition exists
+	if partition != nil {
+		ruleStr = partition.DecisionLogOutcomeRule
+	}
+
+	// Parse rule
+	rule, err := pmodels.ParseOutcomeRule(ruleStr)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Save in cache
+	cache[partitionID] = rule
+
+	return rule, nil
 }
 
 func (s *service) ManageRetention(logger log.Logger, retentionDuration time.Duration, partitionID string) error {
@@ -46,6 +254,30 @@ func (s *service) ManageRetention(logger log.Logger, retentionDuration time.Dura
 	})
 }
 
+func (s *service) PurgePartition(logger log.Logger, partitionID string) error {
+	// Total number of deleted elements
+	var total int64
+	// Delete batches until partition is empty
+	for {
+		// Delete batch
+		count, err := s.dao.DeleteBatchByPartitionID(partitionID, purgeBatchSize)
+		// Check error
+		if err != nil {
+			return err
+		}
+		// Update total
+		total += count
+		// Check if this was the last batch
+		if count < purgeBatchSize {
+			break
+		}
+	}
+
+	logger.Infof("%d decision logs removed from partition %s", total, partitionID)
+
+	return nil
+}
+
 func (s *service) FindByIDOrDecisionID(ctx context.Context, id, did *string, projection *models.Projection) (*models.DecisionLog, error) {
 	// Check if we are in the find by id case
 	if id != nil {
@@ -57,115 +289,244 @@ func (s *service) FindByIDOrDecisionID(ctx context.Context, id, did *string, pro
 }
 
 func (s *service) findByDecisionID(ctx context.Context, did string, projection *models.Projection) (*models.DecisionLog, error) {
+	// Add fields needed for authorization
+	addAuthorizationProjection(projection)
+	// Find decision log
+	res, err := s.dao.FindOneByDecisionID(did, projection)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Find partition of decision log
+	res, partition, err := s.findPartition(res)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get authorization resource
+	resource := getAuthorizationResource("", res, partition)
+	// Decision id is known even if decision log doesn't exist
+	if resource.Attributes == nil {
+		resource.Attributes = map[string]interface{}{"decision_id": did}
+	}
+
 	// Check authorization
-	err := s.authorizationSvc.CheckAuthorized(
+	err = s.authorizationSvc.CheckAuthorizedOnResource(
 		ctx,
 		fmt.Sprintf("%s:FindByDecisionID", mainAuthorizationPrefix),
 		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, did),
+		resource,
 	)
 	// Check error
 	if err != nil {
 		return nil, err
 	}
 
-	return s.dao.FindOneByDecisionID(did, projection)
+	return res, nil
 }
 
 func (s *service) findByID(ctx context.Context, id string, projection *models.Projection) (*models.DecisionLog, error) {
+	// Add fields needed for authorization
+	addAuthorizationProjection(projection)
+	// Find decision log
+	res, err := s.dao.FindByID(id, projection)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Find partition of decision log
+	res, partition, err := s.findPartition(res)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get authorization resource
+	resource := getAuthorizationResource(id, res, partition)
+
 	// Check authorization
-	err := s.authorizationSvc.CheckAuthorized(
+	err = s.authorizationSvc.CheckAuthorizedOnResource(
 		ctx,
 		fmt.Sprintf("%s:FindByID", mainAuthorizationPrefix),
 		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id),
+		resource,
 	)
 	// Check error
 	if err != nil {
 		return nil, err
 	}
 
-	return s.dao.FindByID(id, projection)
+	return res, nil
+}
+
+// findPartition will find partition of decision log in order to build authorization resource.
+// Decision log is hidden when its partition is deleted.
+func (s *service) findPartition(dl *models.DecisionLog) (*models.DecisionLog, *pmodels.Partition, error) {
+	// Check if decision log exists
+	if dl == nil {
+		return nil, nil, nil
+	}
+
+	// Find partition
+	partition, err := s.partitionSvc.UnsecureFindByID(dl.PartitionID)
+	// Check error
+	if err != nil {
+		return nil, nil, err
+	}
+	// Check if partition is deleted
+	if partition == nil {
+		return nil, nil, nil
+	}
+
+	return dl, partition, nil
 }
 
-func (s *service) UnsecureCreate(partitionID string, inp []map[string]interface{}) error {
+func (s *service) UnsecureCreate(partitionID string, inp []map[string]interface{}) (*models.IngestionResult, error) {
 	// Find partition
 	partition, err := s.partitionSvc.UnsecureFindByID(partitionID)
 	// Check error
 	if err != nil {
-		return err
+		return nil, err
 	}
 	// Check if doesn't partition exist
 	if partition == nil {
-		return errors.New("partition doesn't exist")
+		return nil, errors.NewNotFoundError("partition doesn't exist")
+	}
+
+	// Parse outcome rule
+	rule, err := pmodels.ParseOutcomeRule(partition.DecisionLogOutcomeRule)
+	// Check error
+	if err != nil {
+		return nil, err
 	}
 
+	// Create result
+	res := &models.IngestionResult{Rejected: make([]*models.RejectedEntry, 0)}
+	// Create list of decision logs to insert
+	list := make([]*models.DecisionLog, 0, len(inp))
+
 	// Loop over inp
 	for i := 0; i < len(inp); i++ {
-		data := inp[i]
-
-		bb, err := json.Marshal(data)
+		// Build decision log from entry
+		dl, err := s.buildDecisionLog(partition.ID, rule, inp[i])
 		// Check error
 		if err != nil {
-			return err
-		}
+			// Reject entry
+			res.Rejected = append(res.Rejected, &models.RejectedEntry{Index: i, Reason: err.Error()})
 
-		// Create decision logs object
-		dl := &models.DecisionLog{
-			OriginalMessage: string(bb),
-			PartitionID:     partition.ID,
-		}
-		// Add decision id
-		if data["decision_id"] != nil {
-			dl.DecisionID = data["decision_id"].(string)
-		}
-		// Add path
-		if data["path"] != nil {
-			dl.Path = data["path"].(string)
-		}
-		// Add requested by
-		if data["requested_by"] != nil {
-			dl.RequestedBy = data["requested_by"].(string)
+			continue
 		}
-		// Add timestamp
-		if data["timestamp"] != nil {
-			tiStr := data["timestamp"].(string)
 
-			ti, err2 := time.Parse(time.RFC3339, tiStr)
-			// Check error
-			if err2 != nil {
-				return err2
-			}
+		// Append to list
+		list = append(list, dl)
+	}
 
-			dl.Timestamp = ti
-		}
+	// Check if payload must be rejected entirely
+	if len(res.Rejected) != 0 && s.cfgManager.GetConfig().Center.DecisionLogsIngestionMode != config.PartialIngestionMode {
+		return res, errors.NewInvalidInputErrorWithExtensions(
+			"some decision logs are invalid",
+			map[string]interface{}{"rejected": res.Rejected},
+		)
+	}
 
-		// Validate input
-		err = s.validator.Struct(dl)
-		// Check error
-		if err != nil {
-			return err
-		}
+	// Save all decision logs at once
+	// Already existing decision logs will be ignored
+	err = s.dao.CreateMany(list)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
 
-		// Check if decision logs already exists in db
-		item, err := s.dao.FindOneByDecisionID(dl.DecisionID, nil)
-		// Check error
-		if err != nil {
-			return err
-		}
-		// Check if item exists
-		if item != nil {
-			// Skip
-			continue
-		}
+	// Update result
+	res.Accepted = len(list)
+
+	return res, nil
+}
 
-		// Save decision log object
-		err = s.dao.Save(dl)
+func (s *service) buildDecisionLog(partitionID string, rule *pmodels.OutcomeRule, data map[string]interface{}) (*models.DecisionLog, error) {
+	bb, err := json.Marshal(data)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Create decision logs object
+	dl := &models.DecisionLog{
+		OriginalMessage: string(bb),
+		PartitionID:     partitionID,
+	}
+
+	// Add decision id
+	dl.DecisionID, err = getStringField(data, "decision_id")
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Add path
+	dl.Path, err = getStringField(data, "path")
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Add requested by
+	dl.RequestedBy, err = getStringField(data, "requested_by")
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Get timestamp
+	tiStr, err := getStringField(data, "timestamp")
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Add timestamp
+	if tiStr != "" {
+		ti, err := time.Parse(time.RFC3339, tiStr)
 		// Check error
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("timestamp must be a RFC3339 date: %w", err)
 		}
+
+		dl.Timestamp = ti
 	}
 
-	return nil
+	// Extract other fields
+	// Invalid optional fields are ignored because they are still available in original message
+	_, err = extractFields(dl, data, rule)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	err = s.validator.Struct(dl)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return dl, nil
+}
+
+// getStringField will return the value of a string field or an error if it isn't a string.
+func getStringField(data map[string]interface{}, key string) (string, error) {
+	// Check if field exists
+	if data[key] == nil {
+		return "", nil
+	}
+
+	// Cast value
+	v, ok := data[key].(string)
+	// Check if cast was a success
+	if !ok {
+		return "", fmt.Errorf("%s must be a string", key)
+	}
+
+	return v, nil
 }
 
 func (s *service) GetAllPaginated(
@@ -175,25 +536,98 @@ func (s *service) GetAllPaginated(
 	sort *models.SortOrder,
 	filter *models.Filter,
 	projection *models.Projection,
+	search *string,
+	query *string,
+) ([]*models.DecisionLog, *pagination.PageOutput, error) {
+	// Find partition
+	partition, err := s.partitionSvc.UnsecureFindByID(partitionID)
+	// Check error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Create authorization resource with partition
+	resource := &authxmodels.Resource{
+		Type: mainAuthorizationPrefix,
+		Attributes: map[string]interface{}{
+			"partition": pmodels.NewAuthorizationResource(partitionID, partition),
+		},
+	}
+
+	return s.getAllPaginated(ctx, resource, &common.GenericFilter{Eq: partitionID}, page, sort, filter, projection, search, query)
+}
+
+func (s *service) GetAllPaginatedInPartitions(
+	ctx context.Context,
+	partitionID, partitionName *string,
+	page *pagination.PageInput,
+	sort *models.SortOrder,
+	filter *models.Filter,
+	projection *models.Projection,
+	search *string,
+	query *string,
+) ([]*models.DecisionLog, *pagination.PageOutput, error) {
+	// Get authorized partitions
+	ids, err := s.partitionSvc.FindAuthorizedIDs(ctx, partitionID, partitionName)
+	// Check error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.getAllPaginated(
+		ctx,
+		&authxmodels.Resource{Type: mainAuthorizationPrefix},
+		&common.GenericFilter{In: ids},
+		page, sort, filter, projection, search, query,
+	)
+}
+
+func (s *service) getAllPaginated(
+	ctx context.Context,
+	resource *authxmodels.Resource,
+	partitionFilter *common.GenericFilter,
+	page *pagination.PageInput,
+	sort *models.SortOrder,
+	filter *models.Filter,
+	projection *models.Projection,
+	search *string,
+	query *string,
 ) ([]*models.DecisionLog, *pagination.PageOutput, error) {
 	// Check authorization
-	err := s.authorizationSvc.CheckAuthorized(
+	err := s.authorizationSvc.CheckAuthorizedOnResource(
 		ctx,
 		fmt.Sprintf("%s:List", mainAuthorizationPrefix),
 		"",
+		resource,
 	)
 	// Check error
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// Create filter if not exists
-	if filter == nil {
-		filter = &models.Filter{}
+	// Create list of filters
+	// Filters are wrapped in order to not allow OR filters to escape query and partition restrictions
+	ands := make([]*models.Filter, 0)
+	// Check if filter is set
+	if filter != nil {
+		ands = append(ands, filter)
+	}
+
+	// Check if query is set
+	if query != nil {
+		// Parse query
+		qFilter := &models.Filter{}
+		err = common.ParseQuery(*query, qFilter, queryValueMappers)
+		// Check error
+		if err != nil {
+			return nil, nil, err
+		}
+		// Add query filter
+		ands = append(ands, qFilter)
 	}
 
-	// Add partition id to filter
-	filter.PartitionID = &common.GenericFilter{Eq: partitionID}
+	// Create filter with partition filter
+	filter = &models.Filter{AND: ands, PartitionID: partitionFilter}
 
-	return s.dao.GetAllPaginated(page, sort, filter, projection)
+	return s.dao.GetAllPaginated(page, sort, filter, projection, search)
 }
@@ -0,0 +1,378 @@
+//+build unit
+
+package decisionlogs
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	amocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authorization/mocks"
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	daosmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/daos/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func Test_getStringField(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "missing field",
+			data: map[string]interface{}{},
+			want: "",
+		},
+		{
+			name: "null field",
+			data: map[string]interface{}{"path": nil},
+			want: "",
+		},
+		{
+			name: "string field",
+			data: map[string]interface{}{"path": "authz/allow"},
+			want: "authz/allow",
+		},
+		{
+			name:    "number field",
+			data:    map[string]interface{}{"path": float64(1)},
+			wantErr: true,
+		},
+		{
+			name:    "object field",
+			data:    map[string]interface{}{"path": map[string]interface{}{}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getStringField(tt.data, "path")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getStringField() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_service_buildDecisionLog(t *testing.T) {
+	ti, _ := time.Parse(time.RFC3339, "2021-01-02T03:04:05Z")
+
+	tests := []struct {
+		name    string
+		message string
+		want    *models.DecisionLog
+		wantErr bool
+	}{
+		{
+			name:    "valid entry",
+			message: `{"decision_id":"d1","path":"authz/allow","requested_by":"127.0.0.1","timestamp":"2021-01-02T03:04:05Z","result":true}`,
+			want: &models.DecisionLog{
+				DecisionID:  "d1",
+				Path:        "authz/allow",
+				RequestedBy: "127.0.0.1",
+				Timestamp:   ti,
+				PartitionID: "p1",
+				Outcome:     models.OutcomeEnumAllowed,
+			},
+		},
+		{
+			name:    "decision id isn't a string",
+			message: `{"decision_id":1,"path":"authz/allow","requested_by":"127.0.0.1","timestamp":"2021-01-02T03:04:05Z"}`,
+			wantErr: true,
+		},
+		{
+			name:    "path isn't a string",
+			message: `{"decision_id":"d1","path":["authz"],"requested_by":"127.0.0.1","timestamp":"2021-01-02T03:04:05Z"}`,
+			wantErr: true,
+		},
+		{
+			name:    "requested by isn't a string",
+			message: `{"decision_id":"d1","path":"authz/allow","requested_by":false,"timestamp":"2021-01-02T03:04:05Z"}`,
+			wantErr: true,
+		},
+		{
+			name:    "timestamp isn't a RFC3339 date",
+			message: `{"decision_id":"d1","path":"authz/allow","requested_by":"127.0.0.1","timestamp":"yesterday"}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing decision id",
+			message: `{"path":"authz/allow","requested_by":"127.0.0.1","timestamp":"2021-01-02T03:04:05Z"}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing timestamp",
+			message: `{"decision_id":"d1","path":"authz/allow","requested_by":"127.0.0.1"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data map[string]interface{}
+			assert.NoError(t, json.Unmarshal([]byte(tt.message), &data))
+
+			rule, err := pmodels.ParseOutcomeRule("")
+			assert.NoError(t, err)
+
+			s := &service{validator: validator.New()}
+
+			got, err := s.buildDecisionLog("p1", rule, data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("buildDecisionLog() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil {
+				assert.Equal(t, tt.want.DecisionID, got.DecisionID)
+				assert.Equal(t, tt.want.Path, got.Path)
+				assert.Equal(t, tt.want.RequestedBy, got.RequestedBy)
+				assert.True(t, tt.want.Timestamp.Equal(got.Timestamp))
+				assert.Equal(t, tt.want.PartitionID, got.PartitionID)
+				assert.Equal(t, tt.want.Outcome, got.Outcome)
+				assert.JSONEq(t, tt.message, got.OriginalMessage)
+			}
+		})
+	}
+}
+
+func Test_service_migrateExtractedFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Build a full batch in order to check that next batch is requested
+	fullBatch := make([]*models.DecisionLog, 0, extractionBatchSize)
+	for i := 0; i < extractionBatchSize; i++ {
+		fullBatch = append(fullBatch, &models.DecisionLog{
+			ID:              "full",
+			PartitionID:     "p1",
+			OriginalMessage: `{"result": true, "labels": {"id": 1}, "req_id": 4}`,
+		})
+	}
+	lastBatch := []*models.DecisionLog{
+		{ID: "invalid", PartitionID: "p2", OriginalMessage: `not json`},
+	}
+
+	daoMock := daosmocks.NewMockDao(ctrl)
+	gomock.InOrder(
+		daoMock.EXPECT().FindOutdatedExtractions(models.ExtractionVersion, extractionBatchSize).Return(fullBatch, nil),
+		daoMock.EXPECT().UpdateExtractedFields(fullBatch).Return(nil),
+		daoMock.EXPECT().FindOutdatedExtractions(models.ExtractionVersion, extractionBatchSize).Return(lastBatch, nil),
+		daoMock.EXPECT().UpdateExtractedFields(lastBatch).Return(nil),
+	)
+
+	partitionSvcMock := mocks.NewMockPartitionService(ctrl)
+	// Outcome rules must be cached by partition
+	partitionSvcMock.EXPECT().UnsecureFindByID("p1").Return(&pmodels.Partition{}, nil).Times(1)
+	partitionSvcMock.EXPECT().UnsecureFindByID("p2").Return(nil, nil).Times(1)
+
+	s := &service{dao: daoMock, partitionSvc: partitionSvcMock}
+
+	err := s.migrateExtractedFields(log.NewLogger(), 0)
+	assert.NoError(t, err)
+
+	// Invalid optional fields must not prevent extraction of other fields
+	assert.Equal(t, models.OutcomeEnumAllowed, fullBatch[0].Outcome)
+	assert.Nil(t, fullBatch[0].InstanceID)
+	assert.Equal(t, int64(4), *fullBatch[0].ReqID)
+	assert.Equal(t, models.ExtractionVersion, fullBatch[0].ExtractionVersion)
+	// Invalid messages must be marked as extracted in order to not block migration
+	assert.Equal(t, models.ExtractionVersion, lastBatch[0].ExtractionVersion)
+}
+
+func Test_service_buildDecisionLog_invalidOptionalField(t *testing.T) {
+	rule, err := pmodels.ParseOutcomeRule("")
+	assert.NoError(t, err)
+
+	s := &service{validator: validator.New()}
+
+	var data map[string]interface{}
+	err = json.Unmarshal([]byte(`{
+		"decision_id": "did",
+		"path": "authz/allow",
+		"requested_by": "127.0.0.1",
+		"timestamp": "2021-01-01T00:00:00Z",
+		"labels": {"id": 1},
+		"metrics": "fake"
+	}`), &data)
+	assert.NoError(t, err)
+
+	got, err := s.buildDecisionLog("p1", rule, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "did", got.DecisionID)
+	assert.Nil(t, got.InstanceID)
+	assert.Nil(t, got.Metrics)
+}
+
+func Test_service_migrateSearchVectors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	daoMock := daosmocks.NewMockDao(ctrl)
+	gomock.InOrder(
+		daoMock.EXPECT().ComputeSearchVectors(searchVectorBatchSize).Return(int64(searchVectorBatchSize), nil),
+		daoMock.EXPECT().ComputeSearchVectors(searchVectorBatchSize).Return(int64(10), nil),
+	)
+
+	s := &service{dao: daoMock}
+
+	err := s.migrateSearchVectors(log.NewLogger(), 0)
+	assert.NoError(t, err)
+}
+
+// filterSQL will return SQL query generated for a filter.
+func filterSQL(t *testing.T, filter *models.Filter) string {
+	sqlDB, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{DryRun: true, Logger: logger.Discard})
+	assert.NoError(t, err)
+
+	db, err = common.ManageFilter(filter, db)
+	assert.NoError(t, err)
+
+	return db.Table("decision_logs").Find(&[]map[string]interface{}{}).Statement.SQL.String()
+}
+
+func Test_service_GetAllPaginatedInPartitions_orFilterCannotEscape(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	partitionSvcMock := mocks.NewMockPartitionService(ctrl)
+	partitionSvcMock.EXPECT().FindAuthorizedIDs(gomock.Any(), nil, nil).Return([]string{"p1"}, nil)
+
+	authoSvcMock := amocks.NewMockService(ctrl)
+	authoSvcMock.EXPECT().CheckAuthorizedOnResource(gomock.Any(), "decisionlogs:List", "", gomock.Any()).Return(nil)
+
+	var got *models.Filter
+	daoMock := daosmocks.NewMockDao(ctrl)
+	daoMock.EXPECT().
+		GetAllPaginated(nil, nil, gomock.Any(), nil, nil).
+		DoAndReturn(func(_ *pagination.PageInput, _ *models.SortOrder, filter *models.Filter, _ *models.Projection, _ *string) ([]*models.DecisionLog, *pagination.PageOutput, error) {
+			got = filter
+
+			return nil, nil, nil
+		})
+
+	s := &service{dao: daoMock, partitionSvc: partitionSvcMock, authorizationSvc: authoSvcMock}
+
+	// Caller tries to read another partition with an OR filter
+	query := "path:authz"
+	filter := &models.Filter{OR: []*models.Filter{
+		{DecisionID: &common.GenericFilter{Eq: "did"}},
+		{PartitionID: &common.GenericFilter{Eq: "p2"}},
+	}}
+	_, _, err := s.GetAllPaginatedInPartitions(context.TODO(), nil, nil, nil, nil, filter, nil, nil, &query)
+	assert.NoError(t, err)
+
+	// Partition and query restrictions must be applied on the whole caller filter
+	assert.Equal(t,
+		`SELECT * FROM "decision_logs" WHERE partition_id IN ($1) AND (decision_id = $2 OR partition_id = $3) AND path = $4`,
+		filterSQL(t, got),
+	)
+}
+
+func Test_service_FindByIDOrDecisionID(t *testing.T) {
+	partition := &pmodels.Partition{Name: "fake"}
+	partition.ID = "p1"
+	dl := &models.DecisionLog{DecisionID: "d1", Path: "a/b", PartitionID: "p1"}
+	dl.ID = "dl1"
+
+	tests := []struct {
+		name       string
+		id         *string
+		did        *string
+		dl         *models.DecisionLog
+		partition  *pmodels.Partition
+		wantAction string
+		wantResObj *authxmodels.Resource
+		want       *models.DecisionLog
+	}{
+		{
+			name:       "find by id",
+			id:         &dl.ID,
+			dl:         dl,
+			partition:  partition,
+			wantAction: "decisionlogs:FindByID",
+			wantResObj: getAuthorizationResource("dl1", dl, partition),
+			want:       dl,
+		},
+		{
+			name:       "find by id in deleted partition",
+			id:         &dl.ID,
+			dl:         dl,
+			wantAction: "decisionlogs:FindByID",
+			wantResObj: &authxmodels.Resource{Type: "decisionlogs", ID: "dl1"},
+		},
+		{
+			name:       "find by id not found",
+			id:         &dl.ID,
+			wantAction: "decisionlogs:FindByID",
+			wantResObj: &authxmodels.Resource{Type: "decisionlogs", ID: "dl1"},
+		},
+		{
+			name:       "find by decision id",
+			did:        &dl.DecisionID,
+			dl:         dl,
+			partition:  partition,
+			wantAction: "decisionlogs:FindByDecisionID",
+			wantResObj: getAuthorizationResource("", dl, partition),
+			want:       dl,
+		},
+		{
+			name:       "find by decision id in deleted partition",
+			did:        &dl.DecisionID,
+			dl:         dl,
+			wantAction: "decisionlogs:FindByDecisionID",
+			wantResObj: &authxmodels.Resource{
+				Type:       "decisionlogs",
+				Attributes: map[string]interface{}{"decision_id": "d1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			daoMock := daosmocks.NewMockDao(ctrl)
+			if tt.id != nil {
+				daoMock.EXPECT().FindByID(*tt.id, nil).Return(tt.dl, nil)
+			} else {
+				daoMock.EXPECT().FindOneByDecisionID(*tt.did, nil).Return(tt.dl, nil)
+			}
+
+			partitionSvcMock := mocks.NewMockPartitionService(ctrl)
+			if tt.dl != nil {
+				partitionSvcMock.EXPECT().UnsecureFindByID("p1").Return(tt.partition, nil)
+			}
+
+			authoSvcMock := amocks.NewMockService(ctrl)
+			authoSvcMock.EXPECT().
+				CheckAuthorizedOnResource(gomock.Any(), tt.wantAction, gomock.Any(), tt.wantResObj).
+				Return(nil)
+
+			s := &service{dao: daoMock, partitionSvc: partitionSvcMock, authorizationSvc: authoSvcMock}
+
+			got, err := s.FindByIDOrDecisionID(context.TODO(), tt.id, tt.did, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package partitions
+
+import (
+	"crypto/x509"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+)
+
+// isAgentCertificateAllowed will check that one of the certificate identities is allowed for partition.
+func (s *service) isAgentCertificateAllowed(partition *models.Partition, cert *x509.Certificate) bool {
+	// Get configuration
+	cfg := s.cfgManager.GetConfig().Center.AgentCertAuthentication
+	// Check if certificate authentication is enabled
+	if cfg == nil {
+		return false
+	}
+
+	// Get allowed identities
+	allowed := cfg.Partitions[partition.Name]
+	// Get certificate identities
+	identities := certificateIdentities(cert)
+
+	// Search a matching identity
+	for _, a := range allowed {
+		for _, id := range identities {
+			if a == id {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// certificateIdentities will return subject, common name and subject alternative names of certificate.
+func certificateIdentities(cert *x509.Certificate) []string {
+	res := []string{cert.Subject.String()}
+
+	// Add common name
+	if cert.Subject.CommonName != "" {
+		res = append(res, cert.Subject.CommonName)
+	}
+
+	// Add subject alternative names
+	res = append(res, cert.DNSNames...)
+	res = append(res, cert.EmailAddresses...)
+
+	for _, u := range cert.URIs {
+		res = append(res, u.String())
+	}
+
+	for _, ip := range cert.IPAddresses {
+		res = append(res, ip.String())
+	}
+
+	return res
+}
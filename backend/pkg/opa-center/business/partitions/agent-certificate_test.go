@@ -0,0 +1,92 @@
+//+build unit
+
+package partitions
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
+	cmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/config/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_certificateIdentities(t *testing.T) {
+	u, _ := url.Parse("spiffe://cluster/agent")
+	cert := &x509.Certificate{
+		Subject:        pkix.Name{CommonName: "agent", Organization: []string{"org"}},
+		DNSNames:       []string{"agent.example.com"},
+		EmailAddresses: []string{"agent@example.com"},
+		URIs:           []*url.URL{u},
+	}
+
+	assert.Equal(t, []string{
+		"CN=agent,O=org",
+		"agent",
+		"agent.example.com",
+		"agent@example.com",
+		"spiffe://cluster/agent",
+	}, certificateIdentities(cert))
+}
+
+func Test_service_isAgentCertificateAllowed(t *testing.T) {
+	cert := &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "agent"},
+		DNSNames: []string{"agent.example.com"},
+	}
+
+	tests := []struct {
+		name      string
+		cfg       *config.AgentCertAuthConfig
+		partition string
+		want      bool
+	}{
+		{
+			name:      "not configured",
+			partition: "fake",
+			want:      false,
+		},
+		{
+			name: "common name allowed",
+			cfg: &config.AgentCertAuthConfig{Partitions: map[string][]string{
+				"fake": {"agent"},
+			}},
+			partition: "fake",
+			want:      true,
+		},
+		{
+			name: "SAN allowed",
+			cfg: &config.AgentCertAuthConfig{Partitions: map[string][]string{
+				"fake": {"other", "agent.example.com"},
+			}},
+			partition: "fake",
+			want:      true,
+		},
+		{
+			name: "allowed for another partition",
+			cfg: &config.AgentCertAuthConfig{Partitions: map[string][]string{
+				"other": {"agent"},
+			}},
+			partition: "fake",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfgManagerMock := cmocks.NewMockManager(ctrl)
+			cfgManagerMock.EXPECT().GetConfig().Return(&config.Config{
+				Center: &config.CenterConfig{AgentCertAuthentication: tt.cfg},
+			})
+
+			s := &service{cfgManager: cfgManagerMock}
+			assert.Equal(t, tt.want, s.isAgentCertificateAllowed(&models.Partition{Name: tt.partition}, cert))
+		})
+	}
+}
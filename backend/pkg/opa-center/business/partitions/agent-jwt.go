@@ -0,0 +1,120 @@
+package partitions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/coreos/go-oidc"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+)
+
+func (s *service) initializeAgentVerifier() error {
+	// Get configuration
+	cfg := s.cfgManager.GetConfig().Center.AgentJWTAuthentication
+
+	// Create verifier
+	var verifier *oidc.IDTokenVerifier
+	// Check if JWT authentication is enabled
+	if cfg != nil {
+		// Create verifier configuration
+		oidcCfg := &oidc.Config{
+			ClientID:          cfg.Audience,
+			SkipClientIDCheck: cfg.Audience == "",
+			SkipIssuerCheck:   cfg.IssuerURL == "",
+		}
+
+		// Check if keys must be loaded from a JWKS url
+		if cfg.JWKSURL != "" {
+			// Background context is used because key set is refreshed during its whole life
+			keySet := oidc.NewRemoteKeySet(context.Background(), cfg.JWKSURL)
+			verifier = oidc.NewVerifier(cfg.IssuerURL, keySet, oidcCfg)
+		} else {
+			// Discover issuer
+			provider, err := oidc.NewProvider(context.Background(), cfg.IssuerURL)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			verifier = provider.Verifier(oidcCfg)
+		}
+	}
+
+	// Store verifier
+	s.agentVerifierMutex.Lock()
+	s.agentVerifier = verifier
+	s.agentVerifierMutex.Unlock()
+
+	return nil
+}
+
+func (s *service) getAgentVerifier() *oidc.IDTokenVerifier {
+	s.agentVerifierMutex.RLock()
+	defer s.agentVerifierMutex.RUnlock()
+
+	return s.agentVerifier
+}
+
+// checkAgentJWT will verify the JWT and check that the partition claim matches the partition.
+func (s *service) checkAgentJWT(
+	ctx context.Context,
+	verifier *oidc.IDTokenVerifier,
+	partition *models.Partition,
+	rawToken string,
+) error {
+	// Get logger
+	logger := log.GetLoggerFromContext(ctx)
+
+	// Verify token
+	token, err := verifier.Verify(ctx, rawToken)
+	// Check error
+	if err != nil {
+		logger.Error(err)
+
+		return errors.NewUnauthorizedError("unauthorized")
+	}
+
+	// Get claims
+	claims := map[string]interface{}{}
+	err = token.Claims(&claims)
+	// Check error
+	if err != nil {
+		logger.Error(err)
+
+		return errors.NewUnauthorizedError("unauthorized")
+	}
+
+	// Get partition claim name
+	claimName := s.cfgManager.GetConfig().Center.AgentJWTAuthentication.PartitionClaim
+	// Check claim
+	if !claimMatchesPartition(claims[claimName], partition) {
+		logger.Errorf("JWT claim %s doesn't match partition %s", claimName, partition.Name)
+
+		return errors.NewUnauthorizedError("unauthorized")
+	}
+
+	return nil
+}
+
+// claimMatchesPartition will check that claim value is the partition name or id, or a list containing one of them.
+func claimMatchesPartition(claim interface{}, partition *models.Partition) bool {
+	switch v := claim.(type) {
+	case string:
+		return v == partition.Name || v == partition.ID
+	case []interface{}:
+		for _, it := range v {
+			if claimMatchesPartition(it, partition) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isJWT will check if token looks like a compact serialized JWT.
+func isJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
@@ -0,0 +1,68 @@
+//+build unit
+
+package partitions
+
+import (
+	"testing"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_claimMatchesPartition(t *testing.T) {
+	partition := &models.Partition{Name: "fake"}
+	partition.ID = "id"
+
+	tests := []struct {
+		name  string
+		claim interface{}
+		want  bool
+	}{
+		{
+			name:  "missing claim",
+			claim: nil,
+			want:  false,
+		},
+		{
+			name:  "partition name",
+			claim: "fake",
+			want:  true,
+		},
+		{
+			name:  "partition id",
+			claim: "id",
+			want:  true,
+		},
+		{
+			name:  "other partition",
+			claim: "other",
+			want:  false,
+		},
+		{
+			name:  "list containing partition name",
+			claim: []interface{}{"other", "fake"},
+			want:  true,
+		},
+		{
+			name:  "list without partition",
+			claim: []interface{}{"other", 1},
+			want:  false,
+		},
+		{
+			name:  "not a string",
+			claim: 1,
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, claimMatchesPartition(tt.claim, partition))
+		})
+	}
+}
+
+func Test_isJWT(t *testing.T) {
+	assert.True(t, isJWT("header.payload.signature"))
+	assert.False(t, isJWT("0123456789abcdef"))
+	assert.False(t, isJWT("a.b"))
+}
@@ -2,10 +2,12 @@ package partitions
 
 import (
 	"context"
+	"crypto/x509"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authorization"
+	dlqmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/daos"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
@@ -22,7 +24,7 @@ type Service interface {
 	// Reload service
 	Reload() error
 	// Add services
-	AddServices(decisionLogsSvc, statusesSvc RetentionService)
+	AddServices(decisionLogsSvc, statusesSvc RetentionService, deadLettersSvc DeadLettersService)
 	// Migrate database
 	MigrateDB(systemLogger log.Logger) error
 	// Get data paginated
@@ -33,22 +35,62 @@ type Service interface {
 		filter *models.Filter,
 		projection *models.Projection,
 	) ([]*models.Partition, *pagination.PageOutput, error)
-	// Create partition
-	Create(ctx context.Context, inp *models.CreateInput) (*models.Partition, error)
+	// Create partition with its default token.
+	// Token value is only available in the returned token.
+	Create(ctx context.Context, inp *models.CreateInput) (*models.Partition, *models.PartitionToken, error)
 	// Update partition
 	Update(ctx context.Context, inp *models.UpdateInput) (*models.Partition, error)
+	// Delete partition.
+	// Partition is soft deleted and purged with its data after the grace period.
+	Delete(ctx context.Context, inp *models.DeleteInput) (*models.Partition, error)
+	// Restore a deleted partition that isn't purged yet
+	Restore(ctx context.Context, inp *models.RestoreInput) (*models.Partition, error)
+	// Purge a deleted partition with its data without waiting the grace period.
+	// Purge is done in background.
+	Purge(ctx context.Context, inp *models.PurgeInput) (*models.Partition, error)
 	// Find by id used internally only
 	UnsecureFindByID(id string) (*models.Partition, error)
 	// Find by id
 	FindByID(ctx context.Context, id string, projection *models.Projection) (*models.Partition, error)
+	// Find by ids in one database request.
+	// Results and errors are in the same order as ids. A nil partition without error means that it doesn't exist.
+	FindByIDs(ctx context.Context, ids []string, projection *models.Projection) ([]*models.Partition, []error)
+	// Find ids of partitions that caller is authorized to read.
+	// Partition id and name are optional filters.
+	FindAuthorizedIDs(ctx context.Context, id, name *string) ([]string, error)
 	// Generate OPA configuration
 	GenerateOPAConfiguration(ctx context.Context, id string) (string, error)
-	// Check a request is authenticated. This must be used ONLY for data upload in the REST api endpoints.
-	CheckAuthenticated(ctx context.Context, partitionID, authorizationHeader string) error
+	// Check a request is authenticated, with a verified client certificate or an authorization header,
+	// and return the authenticated partition.
+	// This must be used ONLY for data upload in the REST api endpoints.
+	CheckAuthenticated(
+		ctx context.Context,
+		partitionID, authorizationHeader string,
+		clientCert *x509.Certificate,
+	) (*models.Partition, error)
+	// Get all tokens of a partition
+	GetTokens(ctx context.Context, partitionID string) ([]*models.PartitionToken, error)
+	// Find partition token by id
+	FindTokenByID(ctx context.Context, id string) (*models.PartitionToken, error)
+	// Create partition token
+	CreateToken(ctx context.Context, inp *models.CreateTokenInput) (*models.PartitionToken, error)
+	// Revoke partition token
+	RevokeToken(ctx context.Context, inp *models.RevokeTokenInput) (*models.PartitionToken, error)
+	// Rotate partition token: a new token with the same name is created and
+	// the old one stays valid during the overlap window
+	RotateToken(ctx context.Context, inp *models.RotateTokenInput) (*models.PartitionToken, error)
 }
 
+//go:generate mockgen -destination=./mocks/mock_RetentionService.go -package=mocks github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions RetentionService
 type RetentionService interface {
 	ManageRetention(logger log.Logger, retentionDuration time.Duration, partitionID string) error
+	PurgePartition(logger log.Logger, partitionID string) error
+}
+
+//go:generate mockgen -destination=./mocks/mock_DeadLettersService.go -package=mocks github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions DeadLettersService
+type DeadLettersService interface {
+	ManageRetention(logger log.Logger, retentionDuration time.Duration, partitionID string, kind dlqmodels.KindEnum) error
+	PurgePartition(logger log.Logger, partitionID string) error
 }
 
 func NewService(db database.DB, authorizationSvc authorization.Service, cfgManager config.Manager, logger log.Logger) (Service, error) {
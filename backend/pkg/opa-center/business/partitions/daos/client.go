@@ -1,11 +1,15 @@
 package daos
 
 import (
+	"time"
+
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
 )
 
+//go:generate mockgen -destination=./mocks/mock_Dao.go -package=mocks github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/daos Dao
+
 // Dao represent a partition object service.
 type Dao interface {
 	// MigrateDB will migrate database
@@ -17,12 +21,37 @@ type Dao interface {
 		filter *models.Filter,
 		projection *models.Projection,
 	) ([]*models.Partition, *pagination.PageOutput, error)
+	// Find all partitions matching filter without pagination
+	// Limit is ignored when it is 0
+	FindAll(filter *models.Filter, projection *models.Projection, limit int) ([]*models.Partition, error)
 	// Save will save partition object
 	Save(ins *models.Partition) (*models.Partition, error)
+	// Create will insert partition object and its first token in one transaction
+	Create(ins *models.Partition, tok *models.PartitionToken) (*models.Partition, error)
 	// Find by name
 	FindByName(name string, projection *models.Projection) (*models.Partition, error)
 	// Find by id
 	FindByID(id string, projection *models.Projection) (*models.Partition, error)
+	// Find all partitions matching ids in one request
+	FindByIDs(ids []string, projection *models.Projection) ([]*models.Partition, error)
+	// Delete will soft delete partition object
+	Delete(ins *models.Partition) (*models.Partition, error)
+	// Restore will restore a soft deleted partition object
+	Restore(ins *models.Partition) (*models.Partition, error)
+	// Purge will remove permanently partition and its tokens
+	Purge(id string) error
+	// Find soft deleted partition by id
+	FindDeletedByID(id string) (*models.Partition, error)
+	// Find all partitions soft deleted before date
+	FindAllDeletedBefore(date time.Time) ([]*models.Partition, error)
+	// Save token will save partition token object
+	SaveToken(ins *models.PartitionToken) (*models.PartitionToken, error)
+	// Find token by id
+	FindTokenByID(id string) (*models.PartitionToken, error)
+	// Find all tokens of a partition
+	FindTokensByPartitionID(partitionID string) ([]*models.PartitionToken, error)
+	// Find tokens of a partition that aren't revoked or expired at the given time
+	FindActiveTokensByPartitionID(partitionID string, now time.Time) ([]*models.PartitionToken, error)
 }
 
 func NewDao(db database.DB) Dao {
@@ -0,0 +1,290 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/daos (interfaces: Dao)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	pagination "github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
+	reflect "reflect"
+	time "time"
+)
+
+// MockDao is a mock of Dao interface
+type MockDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockDaoMockRecorder
+}
+
+// MockDaoMockRecorder is the mock recorder for MockDao
+type MockDaoMockRecorder struct {
+	mock *MockDao
+}
+
+// NewMockDao creates a new mock instance
+func NewMockDao(ctrl *gomock.Controller) *MockDao {
+	mock := &MockDao{ctrl: ctrl}
+	mock.recorder = &MockDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDao) EXPECT() *MockDaoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockDao) Create(arg0 *models.Partition, arg1 *models.PartitionToken) (*models.Partition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*models.Partition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create
+func (mr *MockDaoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDao)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method
+func (m *MockDao) Delete(arg0 *models.Partition) (*models.Partition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0)
+	ret0, _ := ret[0].(*models.Partition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockDaoMockRecorder) Delete(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDao)(nil).Delete), arg0)
+}
+
+// FindActiveTokensByPartitionID mocks base method
+func (m *MockDao) FindActiveTokensByPartitionID(arg0 string, arg1 time.Time) ([]*models.PartitionToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveTokensByPartitionID", arg0, arg1)
+	ret0, _ := ret[0].([]*models.PartitionToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveTokensByPartitionID indicates an expected call of FindActiveTokensByPartitionID
+func (mr *MockDaoMockRecorder) FindActiveTokensByPartitionID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveTokensByPartitionID", reflect.TypeOf((*MockDao)(nil).FindActiveTokensByPartitionID), arg0, arg1)
+}
+
+// FindAll mocks base method
+func (m *MockDao) FindAll(arg0 *models.Filter, arg1 *models.Projection, arg2 int) ([]*models.Partition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.Partition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll
+func (mr *MockDaoMockRecorder) FindAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockDao)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindAllDeletedBefore mocks base method
+func (m *MockDao) FindAllDeletedBefore(arg0 time.Time) ([]*models.Partition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllDeletedBefore", arg0)
+	ret0, _ := ret[0].([]*models.Partition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllDeletedBefore indicates an expected call of FindAllDeletedBefore
+func (mr *MockDaoMockRecorder) FindAllDeletedBefore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllDeletedBefore", reflect.TypeOf((*MockDao)(nil).FindAllDeletedBefore), arg0)
+}
+
+// FindByID mocks base method
+func (m *MockDao) FindByID(arg0 string, arg1 *models.Projection) (*models.Partition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Partition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID
+func (mr *MockDaoMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockDao)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method
+func (m *MockDao) FindByIDs(arg0 []string, arg1 *models.Projection) ([]*models.Partition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Partition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs
+func (mr *MockDaoMockRecorder) FindByIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockDao)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindByName mocks base method
+func (m *MockDao) FindByName(arg0 string, arg1 *models.Projection) (*models.Partition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByName", arg0, arg1)
+	ret0, _ := ret[0].(*models.Partition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByName indicates an expected call of FindByName
+func (mr *MockDaoMockRecorder) FindByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockDao)(nil).FindByName), arg0, arg1)
+}
+
+// FindDeletedByID mocks base method
+func (m *MockDao) FindDeletedByID(arg0 string) (*models.Partition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", arg0)
+	ret0, _ := ret[0].(*models.Partition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID
+func (mr *MockDaoMockRecorder) FindDeletedByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockDao)(nil).FindDeletedByID), arg0)
+}
+
+// FindTokenByID mocks base method
+func (m *MockDao) FindTokenByID(arg0 string) (*models.PartitionToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTokenByID", arg0)
+	ret0, _ := ret[0].(*models.PartitionToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTokenByID indicates an expected call of FindTokenByID
+func (mr *MockDaoMockRecorder) FindTokenByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTokenByID", reflect.TypeOf((*MockDao)(nil).FindTokenByID), arg0)
+}
+
+// FindTokensByPartitionID mocks base method
+func (m *MockDao) FindTokensByPartitionID(arg0 string) ([]*models.PartitionToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTokensByPartitionID", arg0)
+	ret0, _ := ret[0].([]*models.PartitionToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTokensByPartitionID indicates an expected call of FindTokensByPartitionID
+func (mr *MockDaoMockRecorder) FindTokensByPartitionID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTokensByPartitionID", reflect.TypeOf((*MockDao)(nil).FindTokensByPartitionID), arg0)
+}
+
+// GetAllPaginated mocks base method
+func (m *MockDao) GetAllPaginated(arg0 *pagination.PageInput, arg1 *models.SortOrder, arg2 *models.Filter, arg3 *models.Projection) ([]*models.Partition, *pagination.PageOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPaginated", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Partition)
+	ret1, _ := ret[1].(*pagination.PageOutput)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAllPaginated indicates an expected call of GetAllPaginated
+func (mr *MockDaoMockRecorder) GetAllPaginated(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPaginated", reflect.TypeOf((*MockDao)(nil).GetAllPaginated), arg0, arg1, arg2, arg3)
+}
+
+// MigrateDB mocks base method
+func (m *MockDao) MigrateDB() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateDB")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateDB indicates an expected call of MigrateDB
+func (mr *MockDaoMockRecorder) MigrateDB() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateDB", reflect.TypeOf((*MockDao)(nil).MigrateDB))
+}
+
+// Purge mocks base method
+func (m *MockDao) Purge(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge
+func (mr *MockDaoMockRecorder) Purge(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockDao)(nil).Purge), arg0)
+}
+
+// Restore mocks base method
+func (m *MockDao) Restore(arg0 *models.Partition) (*models.Partition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0)
+	ret0, _ := ret[0].(*models.Partition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore
+func (mr *MockDaoMockRecorder) Restore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockDao)(nil).Restore), arg0)
+}
+
+// Save mocks base method
+func (m *MockDao) Save(arg0 *models.Partition) (*models.Partition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(*models.Partition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save
+func (mr *MockDaoMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockDao)(nil).Save), arg0)
+}
+
+// SaveToken mocks base method
+func (m *MockDao) SaveToken(arg0 *models.PartitionToken) (*models.PartitionToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveToken", arg0)
+	ret0, _ := ret[0].(*models.PartitionToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveToken indicates an expected call of SaveToken
+func (mr *MockDaoMockRecorder) SaveToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveToken", reflect.TypeOf((*MockDao)(nil).SaveToken), arg0)
+}
@@ -2,6 +2,7 @@ package daos
 
 import (
 	"errors"
+	"time"
 
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
@@ -14,13 +15,101 @@ type service struct {
 	db database.DB
 }
 
+// legacyToken represents the authorization token stored in partition table before tokens were introduced.
+type legacyToken struct {
+	ID                 string
+	AuthorizationToken string
+}
+
 func (s *service) MigrateDB() error {
 	// Get gorm database
 	gdb := s.db.GetGormDB()
 	// Migrate
-	err := gdb.AutoMigrate(&models.Partition{})
+	err := gdb.AutoMigrate(&models.Partition{}, &models.PartitionToken{})
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Set metadata defaults
+	err = migrateMetadataDefaults(gdb)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	return migrateLegacyTokens(gdb)
+}
+
+// migrateMetadataDefaults will set empty labels and owners on partitions created before they exist.
+// Filters on these columns would ignore NULL values otherwise.
+func migrateMetadataDefaults(gdb *gorm.DB) error {
+	// Queries setting default values, backfilling existing partitions including deleted ones and refusing NULL values
+	queries := []string{
+		"ALTER TABLE partitions ALTER COLUMN labels SET DEFAULT '{}'::jsonb",
+		"UPDATE partitions SET labels = '{}'::jsonb WHERE labels IS NULL",
+		"ALTER TABLE partitions ALTER COLUMN labels SET NOT NULL",
+		"ALTER TABLE partitions ALTER COLUMN owners SET DEFAULT '[]'::jsonb",
+		"UPDATE partitions SET owners = '[]'::jsonb WHERE owners IS NULL",
+		"ALTER TABLE partitions ALTER COLUMN owners SET NOT NULL",
+	}
+
+	return gdb.Transaction(func(tx *gorm.DB) error {
+		// Loop over queries
+		for _, q := range queries {
+			// Execute query
+			res := tx.Exec(q)
+			// Check error
+			if res.Error != nil {
+				return res.Error
+			}
+		}
 
-	return err
+		return nil
+	})
+}
+
+// migrateLegacyTokens will move partition authorization tokens to hashed default tokens.
+func migrateLegacyTokens(gdb *gorm.DB) error {
+	// Check if legacy authorization token column still exists
+	if !gdb.Migrator().HasColumn(&models.Partition{}, "authorization_token") {
+		return nil
+	}
+
+	return gdb.Transaction(func(tx *gorm.DB) error {
+		// Get legacy tokens
+		list := make([]*legacyToken, 0)
+		res := tx.Table("partitions").
+			Select("id, authorization_token").
+			Where("authorization_token <> ''").
+			Scan(&list)
+		// Check error
+		if res.Error != nil {
+			return res.Error
+		}
+
+		// Create default tokens
+		for _, it := range list {
+			tok := &models.PartitionToken{
+				PartitionID: it.ID,
+				Name:        models.DefaultTokenName,
+			}
+			// Compute hash
+			err := tok.SetValue(it.AuthorizationToken)
+			// Check error
+			if err != nil {
+				return err
+			}
+			// Save
+			res = tx.Create(tok)
+			// Check error
+			if res.Error != nil {
+				return res.Error
+			}
+		}
+
+		return tx.Migrator().DropColumn(&models.Partition{}, "authorization_token")
+	})
 }
 
 func (s *service) Save(ins *models.Partition) (*models.Partition, error) {
@@ -36,6 +125,33 @@ func (s *service) Save(ins *models.Partition) (*models.Partition, error) {
 	return ins, nil
 }
 
+func (s *service) Create(ins *models.Partition, tok *models.PartitionToken) (*models.Partition, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+
+	// Save everything in one transaction in order to never have a partition without token
+	err := gdb.Transaction(func(tx *gorm.DB) error {
+		// Save partition
+		err := tx.Create(ins).Error
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Link token to partition
+		tok.PartitionID = ins.ID
+
+		// Save token
+		return tx.Create(tok).Error
+	})
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return ins, nil
+}
+
 func (s *service) GetAllPaginated(
 	page *pagination.PageInput,
 	sort *models.SortOrder,
@@ -62,6 +178,37 @@ func (s *service) GetAllPaginated(
 	return res, pageOut, nil
 }
 
+func (s *service) FindAll(filter *models.Filter, projection *models.Projection, limit int) ([]*models.Partition, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Apply filter
+	gdb, err := common.ManageFilter(filter, gdb)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Apply projection
+	gdb, err = common.ManageProjection(projection, gdb)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if limit is set
+	if limit != 0 {
+		gdb = gdb.Limit(limit)
+	}
+	// Create result
+	res := make([]*models.Partition, 0)
+	// Request database
+	dbres := gdb.Find(&res)
+	// Check error
+	if dbres.Error != nil {
+		return nil, dbres.Error
+	}
+	// Return result
+	return res, nil
+}
+
 func (s *service) FindByName(name string, projection *models.Projection) (*models.Partition, error) {
 	// Get gorm database
 	gdb := s.db.GetGormDB()
@@ -113,3 +260,170 @@ func (s *service) FindByID(id string, projection *models.Projection) (*models.Pa
 	// Return result
 	return &res, nil
 }
+
+func (s *service) FindByIDs(ids []string, projection *models.Projection) ([]*models.Partition, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Apply projection
+	gdb, err := common.ManageProjection(projection, gdb)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Create result
+	res := make([]*models.Partition, 0)
+	// Request database
+	dbres := gdb.Where("id IN ?", ids).Find(&res)
+	// Check error
+	if dbres.Error != nil {
+		return nil, dbres.Error
+	}
+	// Return result
+	return res, nil
+}
+
+func (s *service) Delete(ins *models.Partition) (*models.Partition, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Soft delete
+	res := gdb.Delete(ins)
+	// Check error
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	// Find partition again in order to get deletion date
+	return s.FindDeletedByID(ins.ID)
+}
+
+func (s *service) Restore(ins *models.Partition) (*models.Partition, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Remove deletion date
+	res := gdb.Unscoped().Model(ins).Update("deleted_at", nil)
+	// Check error
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	// Return result
+	return s.FindByID(ins.ID, nil)
+}
+
+func (s *service) Purge(id string) error {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+
+	// Remove everything in one transaction
+	return gdb.Transaction(func(tx *gorm.DB) error {
+		// Remove tokens
+		res := tx.Unscoped().Where("partition_id = ?", id).Delete(&models.PartitionToken{})
+		// Check error
+		if res.Error != nil {
+			return res.Error
+		}
+
+		// Remove partition
+		return tx.Unscoped().Where("id = ?", id).Delete(&models.Partition{}).Error
+	})
+}
+
+func (s *service) FindDeletedByID(id string) (*models.Partition, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Create result
+	var res models.Partition
+	// Request database
+	dbres := gdb.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).First(&res)
+	// Check error
+	if dbres.Error != nil {
+		// Check if error is a not found error
+		if errors.Is(dbres.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		// Error
+		return nil, dbres.Error
+	}
+	// Return result
+	return &res, nil
+}
+
+func (s *service) FindAllDeletedBefore(date time.Time) ([]*models.Partition, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Create result
+	res := make([]*models.Partition, 0)
+	// Request database
+	dbres := gdb.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", date).Order("deleted_at ASC").Find(&res)
+	// Check error
+	if dbres.Error != nil {
+		return nil, dbres.Error
+	}
+	// Return result
+	return res, nil
+}
+
+func (s *service) SaveToken(ins *models.PartitionToken) (*models.PartitionToken, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Save
+	res := gdb.Save(ins)
+	// Check error
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	// Return result
+	return ins, nil
+}
+
+func (s *service) FindTokenByID(id string) (*models.PartitionToken, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Create result
+	var res models.PartitionToken
+	// Request database
+	dbres := gdb.Where("id = ?", id).First(&res)
+	// Check error
+	if dbres.Error != nil {
+		// Check if error is a not found error
+		if errors.Is(dbres.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		// Error
+		return nil, dbres.Error
+	}
+	// Return result
+	return &res, nil
+}
+
+func (s *service) FindTokensByPartitionID(partitionID string) ([]*models.PartitionToken, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Create result
+	res := make([]*models.PartitionToken, 0)
+	// Request database
+	dbres := gdb.Where("partition_id = ?", partitionID).Order("created_at ASC").Find(&res)
+	// Check error
+	if dbres.Error != nil {
+		return nil, dbres.Error
+	}
+	// Return result
+	return res, nil
+}
+
+func (s *service) FindActiveTokensByPartitionID(partitionID string, now time.Time) ([]*models.PartitionToken, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Create result
+	res := make([]*models.PartitionToken, 0)
+	// Request database
+	dbres := gdb.Where("partition_id = ?", partitionID).
+		Where("revoked_at IS NULL").
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Order("created_at ASC").
+		Find(&res)
+	// Check error
+	if dbres.Error != nil {
+		return nil, dbres.Error
+	}
+	// Return result
+	return res, nil
+}
@@ -0,0 +1,75 @@
+//+build unit
+
+package daos
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func Test_migrateMetadataDefaults(t *testing.T) {
+	tests := []struct {
+		name    string
+		failAt  int
+		wantErr bool
+	}{
+		{
+			name: "set defaults and backfill",
+		},
+		{
+			name:    "rollback on failure",
+			failAt:  2,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer sqlDB.Close()
+
+			db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{Logger: logger.Discard})
+			assert.NoError(t, err)
+
+			queries := []string{
+				`ALTER TABLE partitions ALTER COLUMN labels SET DEFAULT '{}'::jsonb`,
+				`UPDATE partitions SET labels = '{}'::jsonb WHERE labels IS NULL`,
+				`ALTER TABLE partitions ALTER COLUMN labels SET NOT NULL`,
+				`ALTER TABLE partitions ALTER COLUMN owners SET DEFAULT '[]'::jsonb`,
+				`UPDATE partitions SET owners = '[]'::jsonb WHERE owners IS NULL`,
+				`ALTER TABLE partitions ALTER COLUMN owners SET NOT NULL`,
+			}
+
+			mock.ExpectBegin()
+			for i, q := range queries {
+				exp := mock.ExpectExec(regexp.QuoteMeta(q))
+				if tt.wantErr && i == tt.failAt {
+					exp.WillReturnError(errors.New("fake"))
+
+					break
+				}
+				exp.WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			if tt.wantErr {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			err = migrateMetadataDefaults(db)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
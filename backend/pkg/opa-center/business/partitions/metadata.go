@@ -0,0 +1,50 @@
+package partitions
+
+import (
+	"fmt"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+)
+
+// buildLabels will validate labels input and transform it into labels.
+func buildLabels(list []*models.Label) (models.Labels, error) {
+	// Check if labels are set
+	if list == nil {
+		return nil, nil
+	}
+
+	// Create result
+	res := models.Labels{}
+	// Loop over list
+	for _, it := range list {
+		// Check if key is duplicated
+		if _, ok := res[it.Key]; ok {
+			return nil, errors.NewInvalidInputError(fmt.Sprintf("label %s is duplicated", it.Key))
+		}
+		// Save
+		res[it.Key] = it.Value
+	}
+
+	// Validate labels
+	err := models.ValidateLabels(res)
+	// Check error
+	if err != nil {
+		return nil, errors.NewInvalidInputErrorWithError(err)
+	}
+
+	return res, nil
+}
+
+// addAuthorizationProjection will add fields needed for authorization in projection.
+func addAuthorizationProjection(projection *models.Projection) {
+	// Check if a projection is used
+	if projection == nil {
+		return
+	}
+
+	projection.ID = true
+	projection.Name = true
+	projection.Labels = true
+	projection.Owners = true
+}
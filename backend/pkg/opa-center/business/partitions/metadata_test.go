@@ -0,0 +1,62 @@
+//+build unit
+
+package partitions
+
+import (
+	"testing"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_buildLabels(t *testing.T) {
+	tests := []struct {
+		name        string
+		list        []*models.Label
+		want        models.Labels
+		wantErr     bool
+		errorString string
+	}{
+		{
+			name: "nil list",
+			list: nil,
+			want: nil,
+		},
+		{
+			name: "empty list",
+			list: []*models.Label{},
+			want: models.Labels{},
+		},
+		{
+			name: "labels",
+			list: []*models.Label{{Key: "team", Value: "payments"}, {Key: "env", Value: "prod"}},
+			want: models.Labels{"team": "payments", "env": "prod"},
+		},
+		{
+			name:        "duplicated key",
+			list:        []*models.Label{{Key: "team", Value: "payments"}, {Key: "team", Value: "billing"}},
+			wantErr:     true,
+			errorString: "label team is duplicated",
+		},
+		{
+			name:        "invalid key",
+			list:        []*models.Label{{Key: "team.name", Value: "payments"}},
+			wantErr:     true,
+			errorString: "label key team.name must match regex ^[a-zA-Z0-9](?:[-_a-zA-Z0-9]*[a-zA-Z0-9])?$ and be at most 63 characters",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildLabels(tt.list)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("buildLabels() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				assert.Equal(t, tt.errorString, err.Error())
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
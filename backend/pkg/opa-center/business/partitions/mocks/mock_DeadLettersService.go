@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions (interfaces: DeadLettersService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	log "github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+	reflect "reflect"
+	time "time"
+)
+
+// MockDeadLettersService is a mock of DeadLettersService interface
+type MockDeadLettersService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLettersServiceMockRecorder
+}
+
+// MockDeadLettersServiceMockRecorder is the mock recorder for MockDeadLettersService
+type MockDeadLettersServiceMockRecorder struct {
+	mock *MockDeadLettersService
+}
+
+// NewMockDeadLettersService creates a new mock instance
+func NewMockDeadLettersService(ctrl *gomock.Controller) *MockDeadLettersService {
+	mock := &MockDeadLettersService{ctrl: ctrl}
+	mock.recorder = &MockDeadLettersServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeadLettersService) EXPECT() *MockDeadLettersServiceMockRecorder {
+	return m.recorder
+}
+
+// ManageRetention mocks base method
+func (m *MockDeadLettersService) ManageRetention(arg0 log.Logger, arg1 time.Duration, arg2 string, arg3 models.KindEnum) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManageRetention", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ManageRetention indicates an expected call of ManageRetention
+func (mr *MockDeadLettersServiceMockRecorder) ManageRetention(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManageRetention", reflect.TypeOf((*MockDeadLettersService)(nil).ManageRetention), arg0, arg1, arg2, arg3)
+}
+
+// PurgePartition mocks base method
+func (m *MockDeadLettersService) PurgePartition(arg0 log.Logger, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgePartition", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgePartition indicates an expected call of PurgePartition
+func (mr *MockDeadLettersServiceMockRecorder) PurgePartition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgePartition", reflect.TypeOf((*MockDeadLettersService)(nil).PurgePartition), arg0, arg1)
+}
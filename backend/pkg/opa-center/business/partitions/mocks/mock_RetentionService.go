@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions (interfaces: RetentionService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	log "github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+	reflect "reflect"
+	time "time"
+)
+
+// MockRetentionService is a mock of RetentionService interface
+type MockRetentionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRetentionServiceMockRecorder
+}
+
+// MockRetentionServiceMockRecorder is the mock recorder for MockRetentionService
+type MockRetentionServiceMockRecorder struct {
+	mock *MockRetentionService
+}
+
+// NewMockRetentionService creates a new mock instance
+func NewMockRetentionService(ctrl *gomock.Controller) *MockRetentionService {
+	mock := &MockRetentionService{ctrl: ctrl}
+	mock.recorder = &MockRetentionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRetentionService) EXPECT() *MockRetentionServiceMockRecorder {
+	return m.recorder
+}
+
+// ManageRetention mocks base method
+func (m *MockRetentionService) ManageRetention(arg0 log.Logger, arg1 time.Duration, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManageRetention", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ManageRetention indicates an expected call of ManageRetention
+func (mr *MockRetentionServiceMockRecorder) ManageRetention(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManageRetention", reflect.TypeOf((*MockRetentionService)(nil).ManageRetention), arg0, arg1, arg2)
+}
+
+// PurgePartition mocks base method
+func (m *MockRetentionService) PurgePartition(arg0 log.Logger, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgePartition", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgePartition indicates an expected call of PurgePartition
+func (mr *MockRetentionServiceMockRecorder) PurgePartition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgePartition", reflect.TypeOf((*MockRetentionService)(nil).PurgePartition), arg0, arg1)
+}
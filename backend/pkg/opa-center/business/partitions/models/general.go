@@ -13,31 +13,66 @@ type SortOrder struct {
 type Filter struct {
 	AND                  []*Filter
 	OR                   []*Filter
-	CreatedAt            *common.DateFilter    `dbfield:"created_at"`
-	UpdatedAt            *common.DateFilter    `dbfield:"updated_at"`
-	Name                 *common.GenericFilter `dbfield:"name"`
-	StatusDataRetention  *common.GenericFilter `dbfield:"status_data_retention"`
-	DecisionLogRetention *common.GenericFilter `dbfield:"decision_log_retention"`
+	ID                   *common.GenericFilter     `dbfield:"id"`
+	CreatedAt            *common.DateFilter        `dbfield:"created_at"`
+	UpdatedAt            *common.DateFilter        `dbfield:"updated_at"`
+	Name                 *common.GenericFilter     `dbfield:"name"`
+	StatusDataRetention  *common.GenericFilter     `dbfield:"status_data_retention"`
+	DecisionLogRetention *common.GenericFilter     `dbfield:"decision_log_retention"`
+	Description          *common.GenericFilter     `dbfield:"description"`
+	Labels               []*common.JSONFieldFilter `dbfield:"labels"`
+	Owners               *common.JSONArrayFilter   `dbfield:"owners"`
 }
 
 type Projection struct {
-	ID                   bool `dbfield:"id" graphqlfield:"id"`
-	CreatedAt            bool `dbfield:"created_at" graphqlfield:"createdAt"`
-	UpdatedAt            bool `dbfield:"updated_at" graphqlfield:"updatedAt"`
-	Name                 bool `dbfield:"name" graphqlfield:"name"`
-	StatusDataRetention  bool `dbfield:"status_data_retention" graphqlfield:"statusDataRetention"`
-	DecisionLogRetention bool `dbfield:"decision_log_retention" graphqlfield:"decisionLogRetention"`
-	AuthorizationToken   bool `dbfield:"authorization_token"`
+	ID                     bool `dbfield:"id" graphqlfield:"id"`
+	CreatedAt              bool `dbfield:"created_at" graphqlfield:"createdAt"`
+	UpdatedAt              bool `dbfield:"updated_at" graphqlfield:"updatedAt"`
+	Name                   bool `dbfield:"name" graphqlfield:"name"`
+	StatusDataRetention    bool `dbfield:"status_data_retention" graphqlfield:"statusDataRetention"`
+	DecisionLogRetention   bool `dbfield:"decision_log_retention" graphqlfield:"decisionLogRetention"`
+	DecisionLogOutcomeRule bool `dbfield:"decision_log_outcome_rule" graphqlfield:"decisionLogOutcomeRule"`
+	Description            bool `dbfield:"description" graphqlfield:"description"`
+	Labels                 bool `dbfield:"labels" graphqlfield:"labels"`
+	Owners                 bool `dbfield:"owners" graphqlfield:"owners"`
 }
 
 type CreateInput struct {
-	Name                 string `validate:"required,max=255"`
-	StatusDataRetention  string `validate:"omitempty,max=255"`
-	DecisionLogRetention string `validate:"omitempty,max=255"`
+	Name                   string   `validate:"required,max=255"`
+	StatusDataRetention    string   `validate:"omitempty,max=255"`
+	DecisionLogRetention   string   `validate:"omitempty,max=255"`
+	DecisionLogOutcomeRule string   `validate:"omitempty,max=255"`
+	Description            string   `validate:"omitempty,max=1024"`
+	Labels                 []*Label `validate:"omitempty,dive,required"`
+	Owners                 []string `validate:"omitempty,dive,required,max=255"`
 }
 
 type UpdateInput struct {
-	ID                   string  `validate:"required,min=1,max=255"`
-	StatusDataRetention  *string `validate:"omitempty,max=255"`
-	DecisionLogRetention *string `validate:"omitempty,max=255"`
+	ID                     string  `validate:"required,min=1,max=255"`
+	StatusDataRetention    *string `validate:"omitempty,max=255"`
+	DecisionLogRetention   *string `validate:"omitempty,max=255"`
+	DecisionLogOutcomeRule *string `validate:"omitempty,max=255"`
+	Description            *string `validate:"omitempty,max=1024"`
+	// Labels replace all existing labels when set
+	Labels []*Label `validate:"omitempty,dive,required"`
+	// Owners replace all existing owners when set
+	Owners []string `validate:"omitempty,dive,required,max=255"`
+}
+
+// Label is a key/value pair used in inputs and outputs.
+type Label struct {
+	Key   string `validate:"required"`
+	Value string
+}
+
+type DeleteInput struct {
+	ID string `validate:"required,min=1,max=255"`
+}
+
+type RestoreInput struct {
+	ID string `validate:"required,min=1,max=255"`
+}
+
+type PurgeInput struct {
+	ID string `validate:"required,min=1,max=255"`
 }
@@ -0,0 +1,127 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Label key can't contain dots because they are used as separator in JSON filter paths.
+var validLabelKeyRegex = regexp.MustCompile("^[a-zA-Z0-9](?:[-_a-zA-Z0-9]*[a-zA-Z0-9])?$")
+
+const (
+	maxLabelKeyLength   = 63
+	maxLabelValueLength = 255
+)
+
+// Labels are free-form key/value pairs stored as a JSON object.
+type Labels map[string]string
+
+// Value will marshal labels in order to save them in database.
+func (l Labels) Value() (driver.Value, error) {
+	// Check if labels are empty
+	if l == nil {
+		return "{}", nil
+	}
+
+	// Marshal
+	bb, err := json.Marshal(l)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bb), nil
+}
+
+// Scan will unmarshal labels from database.
+func (l *Labels) Scan(value interface{}) error {
+	return scanJSON(value, l)
+}
+
+// GormDataType gorm common data type.
+func (Labels) GormDataType() string {
+	return "json"
+}
+
+// GormDBDataType gorm db data type.
+func (Labels) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return "JSONB"
+}
+
+// Owners are owner contacts stored as a JSON array.
+type Owners []string
+
+// Value will marshal owners in order to save them in database.
+func (o Owners) Value() (driver.Value, error) {
+	// Check if owners are empty
+	if o == nil {
+		return "[]", nil
+	}
+
+	// Marshal
+	bb, err := json.Marshal(o)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bb), nil
+}
+
+// Scan will unmarshal owners from database.
+func (o *Owners) Scan(value interface{}) error {
+	return scanJSON(value, o)
+}
+
+// GormDataType gorm common data type.
+func (Owners) GormDataType() string {
+	return "json"
+}
+
+// GormDBDataType gorm db data type.
+func (Owners) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return "JSONB"
+}
+
+// ValidateLabels will check label keys and values.
+func ValidateLabels(labels Labels) error {
+	// Loop over labels
+	for k, v := range labels {
+		// Check key
+		if len(k) > maxLabelKeyLength || !validLabelKeyRegex.MatchString(k) {
+			return fmt.Errorf("label key %s must match regex %s and be at most %d characters", k, validLabelKeyRegex.String(), maxLabelKeyLength)
+		}
+		// Check value
+		if len(v) > maxLabelValueLength {
+			return fmt.Errorf("label %s value must be at most %d characters", k, maxLabelValueLength)
+		}
+	}
+
+	return nil
+}
+
+func scanJSON(value, dest interface{}) error {
+	// Check null value
+	if value == nil {
+		return nil
+	}
+
+	// Get bytes
+	var bb []byte
+	// Check type
+	switch v := value.(type) {
+	case []byte:
+		bb = v
+	case string:
+		bb = []byte(v)
+	default:
+		return fmt.Errorf("failed to unmarshal JSON value: %v", value)
+	}
+
+	return json.Unmarshal(bb, dest)
+}
@@ -0,0 +1,101 @@
+//+build unit
+
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateLabels(t *testing.T) {
+	tests := []struct {
+		name        string
+		labels      Labels
+		wantErr     bool
+		errorString string
+	}{
+		{
+			name:   "nil labels",
+			labels: nil,
+		},
+		{
+			name:   "valid labels",
+			labels: Labels{"team": "payments", "cost-center_1": ""},
+		},
+		{
+			name:        "key with dot",
+			labels:      Labels{"app.kubernetes.io": "fake"},
+			wantErr:     true,
+			errorString: "label key app.kubernetes.io must match regex ^[a-zA-Z0-9](?:[-_a-zA-Z0-9]*[a-zA-Z0-9])?$ and be at most 63 characters",
+		},
+		{
+			name:        "empty key",
+			labels:      Labels{"": "fake"},
+			wantErr:     true,
+			errorString: "label key  must match regex ^[a-zA-Z0-9](?:[-_a-zA-Z0-9]*[a-zA-Z0-9])?$ and be at most 63 characters",
+		},
+		{
+			name:        "key too long",
+			labels:      Labels{strings.Repeat("a", 64): "fake"},
+			wantErr:     true,
+			errorString: "label key " + strings.Repeat("a", 64) + " must match regex ^[a-zA-Z0-9](?:[-_a-zA-Z0-9]*[a-zA-Z0-9])?$ and be at most 63 characters",
+		},
+		{
+			name:        "value too long",
+			labels:      Labels{"team": strings.Repeat("a", 256)},
+			wantErr:     true,
+			errorString: "label team value must be at most 255 characters",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLabels(tt.labels)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLabels() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				assert.Equal(t, tt.errorString, err.Error())
+			}
+		})
+	}
+}
+
+func TestLabels_ValueAndScan(t *testing.T) {
+	// Nil labels are saved as an empty object
+	v, err := Labels(nil).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "{}", v)
+
+	v, err = Labels{"team": "payments"}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"team":"payments"}`, v)
+
+	var l Labels
+	assert.NoError(t, l.Scan([]byte(`{"team":"payments"}`)))
+	assert.Equal(t, Labels{"team": "payments"}, l)
+
+	// Null values are kept empty
+	var l2 Labels
+	assert.NoError(t, l2.Scan(nil))
+	assert.Nil(t, l2)
+
+	assert.Error(t, l2.Scan(42))
+}
+
+func TestOwners_ValueAndScan(t *testing.T) {
+	// Nil owners are saved as an empty array
+	v, err := Owners(nil).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", v)
+
+	v, err = Owners{"alice@example.com"}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `["alice@example.com"]`, v)
+
+	var o Owners
+	assert.NoError(t, o.Scan(`["alice@example.com","bob@example.com"]`))
+	assert.Equal(t, Owners{"alice@example.com", "bob@example.com"}, o)
+}
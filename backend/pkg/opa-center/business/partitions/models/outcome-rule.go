@@ -0,0 +1,88 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Prefix used to negate an outcome rule.
+const outcomeRuleNegationPrefix = "!"
+
+// OutcomeRule represents a rule used to classify decisions as allowed, denied or undefined.
+// Rule is a JSON pointer (RFC 6901) into the decision result that must target a boolean,
+// optionally prefixed by "!" in order to classify true as denied.
+// An empty pointer targets the whole result.
+type OutcomeRule struct {
+	tokens []string
+	negate bool
+}
+
+// ParseOutcomeRule will parse an outcome rule.
+func ParseOutcomeRule(rule string) (*OutcomeRule, error) {
+	// Create result
+	res := &OutcomeRule{}
+
+	// Check if rule is negated
+	if strings.HasPrefix(rule, outcomeRuleNegationPrefix) {
+		res.negate = true
+		rule = strings.TrimPrefix(rule, outcomeRuleNegationPrefix)
+	}
+
+	// Check if rule targets the whole result
+	if rule == "" {
+		return res, nil
+	}
+
+	// Check that rule is a JSON pointer
+	if !strings.HasPrefix(rule, "/") {
+		return nil, fmt.Errorf("outcome rule %s must be a JSON pointer starting with /", rule)
+	}
+
+	// Loop over pointer tokens
+	for _, tok := range strings.Split(rule, "/")[1:] {
+		// Check escape sequences
+		if strings.Count(tok, "~") != strings.Count(tok, "~0")+strings.Count(tok, "~1") {
+			return nil, fmt.Errorf("outcome rule %s contains an invalid escape sequence", rule)
+		}
+		// Unescape and append
+		res.tokens = append(res.tokens, strings.ReplaceAll(strings.ReplaceAll(tok, "~1", "/"), "~0", "~"))
+	}
+
+	return res, nil
+}
+
+// Evaluate will classify decision result.
+// Defined will be false when targeted value isn't a boolean.
+func (r *OutcomeRule) Evaluate(result interface{}) (allowed, defined bool) {
+	// Current value
+	v := result
+
+	// Loop over tokens
+	for _, tok := range r.tokens {
+		switch cv := v.(type) {
+		case map[string]interface{}:
+			v = cv[tok]
+		case []interface{}:
+			// Parse index
+			i, err := strconv.Atoi(tok)
+			// Check error
+			if err != nil || i < 0 || i >= len(cv) {
+				return false, false
+			}
+
+			v = cv[i]
+		default:
+			return false, false
+		}
+	}
+
+	// Cast value
+	b, ok := v.(bool)
+	// Check if cast was a success
+	if !ok {
+		return false, false
+	}
+
+	return b != r.negate, true
+}
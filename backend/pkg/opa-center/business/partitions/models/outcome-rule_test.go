@@ -0,0 +1,139 @@
+//+build unit
+
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOutcomeRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		want    *OutcomeRule
+		wantErr bool
+	}{
+		{
+			name: "empty rule",
+			rule: "",
+			want: &OutcomeRule{},
+		},
+		{
+			name: "negated empty rule",
+			rule: "!",
+			want: &OutcomeRule{negate: true},
+		},
+		{
+			name: "pointer",
+			rule: "/allow",
+			want: &OutcomeRule{tokens: []string{"allow"}},
+		},
+		{
+			name: "negated pointer with escape sequences",
+			rule: "!/deny/a~1b/c~0d/0",
+			want: &OutcomeRule{tokens: []string{"deny", "a/b", "c~d", "0"}, negate: true},
+		},
+		{
+			name:    "not a pointer",
+			rule:    "allow",
+			wantErr: true,
+		},
+		{
+			name:    "invalid escape sequence",
+			rule:    "/a~2",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseOutcomeRule(tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseOutcomeRule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOutcomeRule_Evaluate(t *testing.T) {
+	tests := []struct {
+		name        string
+		rule        string
+		result      string
+		wantAllowed bool
+		wantDefined bool
+	}{
+		{
+			name:        "boolean true",
+			result:      `true`,
+			wantAllowed: true,
+			wantDefined: true,
+		},
+		{
+			name:        "boolean false",
+			result:      `false`,
+			wantDefined: true,
+		},
+		{
+			name:   "not a boolean",
+			result: `{"allow":true}`,
+		},
+		{
+			name:   "missing result",
+			result: `null`,
+		},
+		{
+			name:        "pointer",
+			rule:        "/allow",
+			result:      `{"allow":true}`,
+			wantAllowed: true,
+			wantDefined: true,
+		},
+		{
+			name:        "negated pointer",
+			rule:        "!/deny",
+			result:      `{"deny":true}`,
+			wantDefined: true,
+		},
+		{
+			name:        "pointer with array index",
+			rule:        "/items/1/allow",
+			result:      `{"items":[{"allow":false},{"allow":true}]}`,
+			wantAllowed: true,
+			wantDefined: true,
+		},
+		{
+			name:   "pointer with invalid array index",
+			rule:   "/items/2",
+			result: `{"items":[true]}`,
+		},
+		{
+			name:   "pointer on missing key",
+			rule:   "/allow",
+			result: `{}`,
+		},
+		{
+			name:   "pointer on scalar",
+			rule:   "/allow",
+			result: `true`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseOutcomeRule(tt.rule)
+			assert.NoError(t, err)
+
+			var result interface{}
+			err = json.Unmarshal([]byte(tt.result), &result)
+			assert.NoError(t, err)
+
+			allowed, defined := r.Evaluate(result)
+			assert.Equal(t, tt.wantAllowed, allowed)
+			assert.Equal(t, tt.wantDefined, defined)
+		})
+	}
+}
@@ -1,13 +1,58 @@
 package models
 
 import (
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
 )
 
+// AuthorizationResourceType is the type of partitions in authorization resources.
+const AuthorizationResourceType = "partitions"
+
 type Partition struct {
 	database.Base
-	Name                 string `gorm:"unique_index"`
-	StatusDataRetention  string
-	DecisionLogRetention string
-	AuthorizationToken   string
+	Name                   string `gorm:"unique_index"`
+	StatusDataRetention    string
+	DecisionLogRetention   string
+	DecisionLogOutcomeRule string
+	Description            string
+	Labels                 Labels
+	Owners                 Owners
+}
+
+// GetAuthorizationResource will return partition representation sent to OPA servers
+// in order to allow policies based on partition metadata.
+func (p *Partition) GetAuthorizationResource() *authxmodels.Resource {
+	// Get labels
+	labels := p.Labels
+	// Send an empty object rather than null
+	if labels == nil {
+		labels = Labels{}
+	}
+	// Get owners
+	owners := p.Owners
+	// Send an empty array rather than null
+	if owners == nil {
+		owners = Owners{}
+	}
+
+	return &authxmodels.Resource{
+		Type: AuthorizationResourceType,
+		ID:   p.ID,
+		Name: p.Name,
+		Attributes: map[string]interface{}{
+			"labels": labels,
+			"owners": owners,
+		},
+	}
+}
+
+// NewAuthorizationResource will return resource sent to OPA servers for a partition id.
+// Only type and id are known when partition doesn't exist.
+func NewAuthorizationResource(id string, partition *Partition) *authxmodels.Resource {
+	// Check if partition exists
+	if partition == nil {
+		return &authxmodels.Resource{Type: AuthorizationResourceType, ID: id}
+	}
+
+	return partition.GetAuthorizationResource()
 }
@@ -0,0 +1,43 @@
+//+build unit
+
+package models
+
+import (
+	"testing"
+
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuthorizationResource(t *testing.T) {
+	assert.Equal(t, &authxmodels.Resource{
+		Type: "partitions",
+		ID:   "id1",
+	}, NewAuthorizationResource("id1", nil))
+
+	assert.Equal(t, &authxmodels.Resource{
+		Type: "partitions",
+		ID:   "id1",
+		Name: "fake",
+		Attributes: map[string]interface{}{
+			"labels": Labels{},
+			"owners": Owners{},
+		},
+	}, NewAuthorizationResource("id1", &Partition{Name: "fake", Base: database.Base{ID: "id1"}}))
+
+	assert.Equal(t, &authxmodels.Resource{
+		Type: "partitions",
+		ID:   "id1",
+		Name: "fake",
+		Attributes: map[string]interface{}{
+			"labels": Labels{"team": "payments"},
+			"owners": Owners{"alice@example.com"},
+		},
+	}, NewAuthorizationResource("id1", &Partition{
+		Base:   database.Base{ID: "id1"},
+		Name:   "fake",
+		Labels: Labels{"team": "payments"},
+		Owners: Owners{"alice@example.com"},
+	}))
+}
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"time"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
+)
+
+// DefaultTokenName is the name of the token created with a partition.
+const DefaultTokenName = "default"
+
+const (
+	tokenValueSize = 32
+	tokenSaltSize  = 16
+)
+
+type PartitionToken struct {
+	database.Base
+	PartitionID string `gorm:"index"`
+	Name        string
+	// Value is never stored, it is only available on creation or rotation
+	Value     string `gorm:"-"`
+	Salt      string
+	Hash      string
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+}
+
+// GenerateTokenValue will generate a new random token value.
+func GenerateTokenValue() (string, error) {
+	return randomHex(tokenValueSize)
+}
+
+// SetValue will store a salted hash of the value.
+// Value is only kept in memory in order to be displayed once.
+func (t *PartitionToken) SetValue(value string) error {
+	// Generate salt
+	salt, err := randomHex(tokenSaltSize)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	t.Value = value
+	t.Salt = salt
+	t.Hash = hashTokenValue(salt, value)
+
+	return nil
+}
+
+// Matches will check, in constant time, that value corresponds to the stored hash.
+func (t *PartitionToken) Matches(value string) bool {
+	return subtle.ConstantTimeCompare([]byte(t.Hash), []byte(hashTokenValue(t.Salt, value))) == 1
+}
+
+// IsActive will return true if token isn't revoked and isn't expired at the given time.
+func (t *PartitionToken) IsActive(now time.Time) bool {
+	// Check if token is revoked
+	if t.RevokedAt != nil {
+		return false
+	}
+
+	return t.ExpiresAt == nil || t.ExpiresAt.After(now)
+}
+
+type CreateTokenInput struct {
+	PartitionID string  `validate:"required,min=1,max=255"`
+	Name        string  `validate:"required,max=255"`
+	ExpiresAt   *string `validate:"omitempty,max=255"`
+}
+
+type RevokeTokenInput struct {
+	ID string `validate:"required,min=1,max=255"`
+}
+
+type RotateTokenInput struct {
+	ID              string  `validate:"required,min=1,max=255"`
+	OverlapDuration *string `validate:"omitempty,max=255"`
+	ExpiresAt       *string `validate:"omitempty,max=255"`
+}
+
+func hashTokenValue(salt, value string) string {
+	h := hmac.New(sha256.New, []byte(salt))
+	// Write never returns an error on hash
+	_, _ = h.Write([]byte(value))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func randomHex(size int) (string, error) {
+	b := make([]byte, size)
+	// Read random bytes
+	_, err := rand.Read(b)
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,86 @@
+//+build unit
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionToken_IsActive(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name  string
+		token *PartitionToken
+		want  bool
+	}{
+		{
+			name:  "no expiration and not revoked",
+			token: &PartitionToken{},
+			want:  true,
+		},
+		{
+			name:  "expiration in the future",
+			token: &PartitionToken{ExpiresAt: &future},
+			want:  true,
+		},
+		{
+			name:  "expired",
+			token: &PartitionToken{ExpiresAt: &past},
+			want:  false,
+		},
+		{
+			name:  "expiration at now",
+			token: &PartitionToken{ExpiresAt: &now},
+			want:  false,
+		},
+		{
+			name:  "revoked",
+			token: &PartitionToken{ExpiresAt: &future, RevokedAt: &past},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.token.IsActive(now))
+		})
+	}
+}
+
+func TestPartitionToken_SetValue(t *testing.T) {
+	value, err := GenerateTokenValue()
+	assert.NoError(t, err)
+	assert.Len(t, value, 2*tokenValueSize)
+
+	tok := &PartitionToken{}
+	err = tok.SetValue(value)
+	assert.NoError(t, err)
+
+	assert.Equal(t, value, tok.Value)
+	assert.Len(t, tok.Salt, 2*tokenSaltSize)
+	assert.NotEmpty(t, tok.Hash)
+	assert.NotContains(t, tok.Hash, value)
+
+	// Same value must give another hash with another salt
+	tok2 := &PartitionToken{}
+	err = tok2.SetValue(value)
+	assert.NoError(t, err)
+	assert.NotEqual(t, tok.Hash, tok2.Hash)
+}
+
+func TestPartitionToken_Matches(t *testing.T) {
+	tok := &PartitionToken{}
+	err := tok.SetValue("fake-token")
+	assert.NoError(t, err)
+	// Simulate a token loaded from database
+	tok.Value = ""
+
+	assert.True(t, tok.Matches("fake-token"))
+	assert.False(t, tok.Matches("fake-token2"))
+	assert.False(t, tok.Matches(""))
+}
@@ -0,0 +1,81 @@
+package partitions
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+)
+
+type PurgeTask struct {
+	s      *service
+	logger log.Logger
+	// inProgress is set to 1 when a run is in progress.
+	// It is accessed atomically because cron runs and reloads can happen concurrently.
+	inProgress int32
+}
+
+func (r *PurgeTask) Description() string { return "Deleted partitions purge processing task" }
+func (r *PurgeTask) Key() int            { return 2 }
+
+func (r *PurgeTask) endCurrentTask() {
+	atomic.StoreInt32(&r.inProgress, 0)
+}
+
+func (r *PurgeTask) buildCurrentLogger() log.Logger {
+	return r.logger.WithField("task-id", time.Now().Unix())
+}
+
+func (r *PurgeTask) Run() {
+	// Build logger
+	logger := r.buildCurrentLogger()
+	// Check if another run isn't already in progress and store fact that task is in progress
+	if !atomic.CompareAndSwapInt32(&r.inProgress, 0, 1) {
+		logger.Info("Another partition purge is already in progress => Skipping this run")
+
+		return
+	}
+
+	// Defer end current task
+	defer r.endCurrentTask()
+
+	logger.Info("Starting partition purge processing task")
+
+	err := r.runTask(logger)
+	// Check error
+	if err != nil {
+		logger.Error(err)
+
+		return
+	}
+
+	logger.Info("Partition purge processing task ended")
+}
+
+func (r *PurgeTask) runTask(logger log.Logger) error {
+	// Get grace period
+	gracePeriod, err := time.ParseDuration(r.s.cfgManager.GetConfig().Center.PartitionPurgeGracePeriod)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Get partitions deleted before grace period
+	list, err := r.s.dao.FindAllDeletedBefore(time.Now().Add(-gracePeriod))
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Loop over the list
+	for _, item := range list {
+		// Purge partition
+		err = r.s.purgePartition(logger, item)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
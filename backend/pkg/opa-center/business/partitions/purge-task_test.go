@@ -0,0 +1,58 @@
+//+build unit
+
+package partitions
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	daosmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/daos/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
+	cmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/config/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPurgeTask_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfgManagerMock := cmocks.NewMockManager(ctrl)
+	cfgManagerMock.EXPECT().GetConfig().Return(&config.Config{
+		Center: &config.CenterConfig{PartitionPurgeGracePeriod: "1h"},
+	})
+
+	// Channel used to block first run
+	release := make(chan struct{})
+	// Channel closed when first run is started
+	started := make(chan struct{})
+
+	daoMock := daosmocks.NewMockDao(ctrl)
+	// Only one run must request database
+	daoMock.EXPECT().FindAllDeletedBefore(gomock.Any()).DoAndReturn(func(time.Time) ([]*models.Partition, error) {
+		close(started)
+		<-release
+
+		return nil, nil
+	})
+
+	r := &PurgeTask{s: &service{dao: daoMock, cfgManager: cfgManagerMock}, logger: log.NewLogger()}
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		r.Run()
+	}()
+
+	<-started
+	// Run skipped because another one is in progress
+	r.Run()
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(0), r.inProgress)
+}
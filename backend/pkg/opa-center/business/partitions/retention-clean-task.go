@@ -3,6 +3,7 @@ package partitions
 import (
 	"time"
 
+	dlqmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
@@ -58,7 +59,7 @@ func (r *RetentionCleanTask) Run() {
 
 func (r *RetentionCleanTask) runTask(logger log.Logger) error {
 	// Initialize page input
-	pageIn := &pagination.PageInput{Limit: ListLimit}
+	pageIn := &pagination.PageInput{Limit: ListLimit, WithoutCount: true}
 
 	// While page input exists, loop to run the task
 	for pageIn != nil {
@@ -90,6 +91,12 @@ func (r *RetentionCleanTask) runTask(logger log.Logger) error {
 				if err != nil {
 					return err
 				}
+				// Start retention clean process on rejected decision logs
+				err = r.s.deadLettersSvc.ManageRetention(logger, retentionDuration, item.ID, dlqmodels.KindEnumDecisionLogs)
+				// Check error
+				if err != nil {
+					return err
+				}
 			}
 			// Decision log duration case
 			if item.StatusDataRetention != "" {
@@ -104,6 +111,12 @@ func (r *RetentionCleanTask) runTask(logger log.Logger) error {
 				if err != nil {
 					return err
 				}
+				// Start retention clean process on rejected statuses
+				err = r.s.deadLettersSvc.ManageRetention(logger, retentionDuration, item.ID, dlqmodels.KindEnumStatus)
+				// Check error
+				if err != nil {
+					return err
+				}
 			}
 		}
 
@@ -111,7 +124,11 @@ func (r *RetentionCleanTask) runTask(logger log.Logger) error {
 		pageIn = nil
 		// Calculate next pagination input if there is a next page
 		if pOut.HasNext {
-			pageIn = &pagination.PageInput{Limit: ListLimit, Skip: pOut.Skip + ListLimit}
+			pageIn = &pagination.PageInput{
+				Limit:        ListLimit,
+				After:        pOut.Cursors[len(pOut.Cursors)-1],
+				WithoutCount: true,
+			}
 		}
 	}
 
@@ -3,26 +3,38 @@ package partitions
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"fmt"
 	"path"
 	"regexp"
 	"strings"
+	"sync"
 	"text/template"
 	"time"
 
+	"github.com/coreos/go-oidc"
 	"github.com/go-playground/validator/v10"
-	"github.com/gofrs/uuid"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authorization"
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/daos"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
 	"github.com/robfig/cron/v3"
 )
 
-const mainAuthorizationPrefix = "partitions"
+const mainAuthorizationPrefix = models.AuthorizationResourceType
+
+// Maximum number of partitions checked one by one when searching authorized partitions.
+const maxAuthorizationChecks = 100
+
+const (
+	tokenAuthorizationScheme  = "Token"
+	bearerAuthorizationScheme = "Bearer"
+)
 
 var validNameRegex = regexp.MustCompile("^[a-z0-9][a-z0-9]*(?:-+[a-z0-9]+)*$")
 
@@ -35,20 +47,34 @@ type service struct {
 	retentionScheduler *cron.Cron
 	decisionLogsSvc    RetentionService
 	statusesSvc        RetentionService
+	deadLettersSvc     DeadLettersService
 	logger             log.Logger
+	agentVerifier      *oidc.IDTokenVerifier
+	agentVerifierMutex sync.RWMutex
+	purgeMutex         sync.Mutex
 }
 
 type opaCfgData struct {
 	Partition  *models.Partition
 	ServiceURL string
+	ClientTLS  bool
+	OAuth2     *config.AgentJWTAuthConfig
 }
 
-func (s *service) AddServices(decisionLogsSvc, statusesSvc RetentionService) {
+func (s *service) AddServices(decisionLogsSvc, statusesSvc RetentionService, deadLettersSvc DeadLettersService) {
 	s.decisionLogsSvc = decisionLogsSvc
 	s.statusesSvc = statusesSvc
+	s.deadLettersSvc = deadLettersSvc
 }
 
 func (s *service) Initialize() error {
+	// Initialize agent JWT verifier
+	err := s.initializeAgentVerifier()
+	// Check error
+	if err != nil {
+		return err
+	}
+
 	return s.initializeCron(true)
 }
 
@@ -68,14 +94,23 @@ func (s *service) initializeCron(isStartup bool) error {
 	if err != nil {
 		return err
 	}
+	// Create purge task
+	purgeTask := &PurgeTask{s: s, logger: s.logger.WithField("task", "partition-purge-process")}
+	// Add purge task
+	_, err = c.AddJob(cfg.CronRetentionProcess, purgeTask)
+	// Check error
+	if err != nil {
+		return err
+	}
 
 	// Start cron
 	c.Start()
 
 	// Check if a startup run is asked
 	if isStartup && !cfg.SkipRetentionProcessAtStartup {
-		// Start go routine to start task
+		// Start go routines to start tasks
 		go task.Run()
+		go purgeTask.Run()
 	}
 
 	// Store scheduler
@@ -85,6 +120,13 @@ func (s *service) initializeCron(isStartup bool) error {
 }
 
 func (s *service) Reload() error {
+	// Reload agent JWT verifier
+	err := s.initializeAgentVerifier()
+	// Check error
+	if err != nil {
+		return err
+	}
+
 	// Stop scheduler
 	s.retentionScheduler.Stop()
 
@@ -98,47 +140,93 @@ func (s *service) MigrateDB(systemLogger log.Logger) error {
 	return s.dao.MigrateDB()
 }
 
-func (s *service) CheckAuthenticated(ctx context.Context, partitionID, authorizationHeader string) error {
+func (s *service) CheckAuthenticated(
+	ctx context.Context,
+	partitionID, authorizationHeader string,
+	clientCert *x509.Certificate,
+) (*models.Partition, error) {
 	// Get logger
 	logger := log.GetLoggerFromContext(ctx)
 
+	// Check that request contains credentials
+	if authorizationHeader == "" && clientCert == nil {
+		logger.Error("No authorization header content or client certificate detected")
+
+		return nil, errors.NewUnauthorizedError("unauthorized")
+	}
+
+	// Find partition with given id
+	partition, err := s.dao.FindByID(partitionID, &models.Projection{ID: true, Name: true})
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if partition is found
+	if partition == nil {
+		logger.Errorf("Partition with id %s cannot be found in database", partitionID)
+
+		return nil, errors.NewUnauthorizedError("unauthorized")
+	}
+
+	// Check if client certificate is allowed for partition
+	if clientCert != nil && s.isAgentCertificateAllowed(partition, clientCert) {
+		return partition, nil
+	}
+
 	// Check that authentication header isn't empty
 	if authorizationHeader == "" {
-		logger.Error("No authorization header content detected")
+		logger.Error("No authorization header content detected and client certificate isn't allowed for partition")
 
-		return errors.NewUnauthorizedError("unauthorized")
+		return nil, errors.NewUnauthorizedError("unauthorized")
 	}
 
 	// Parse authentication header
-	authorizationSplit := strings.Split(authorizationHeader, " ")
-	// Check that first part is "Token"
-	if authorizationSplit[0] != "Token" {
-		logger.Error("Authorization header don't start with Token prefix")
+	authorizationSplit := strings.SplitN(authorizationHeader, " ", 2)
+	// Check that header contains a scheme and a credential
+	if len(authorizationSplit) != 2 {
+		logger.Error("Authorization header don't contain any scheme")
 
-		return errors.NewUnauthorizedError("unauthorized")
+		return nil, errors.NewUnauthorizedError("unauthorized")
 	}
+	// Get scheme and credential
+	scheme, credential := authorizationSplit[0], authorizationSplit[1]
+	// Check that scheme is supported
+	if !strings.EqualFold(scheme, tokenAuthorizationScheme) && !strings.EqualFold(scheme, bearerAuthorizationScheme) {
+		logger.Error("Authorization header don't start with Token or Bearer scheme")
 
-	// Find partition with given id
-	partition, err := s.dao.FindByID(partitionID, &models.Projection{ID: true, AuthorizationToken: true})
-	// Check error
-	if err != nil {
-		return err
+		return nil, errors.NewUnauthorizedError("unauthorized")
 	}
-	// Check if partition is found
-	if partition == nil {
-		logger.Errorf("Partition with id %d cannot be found in database", partitionID)
 
-		return errors.NewUnauthorizedError("unauthorized")
+	// Check if credential is a JWT that must be validated against the configured issuer
+	verifier := s.getAgentVerifier()
+	if verifier != nil && strings.EqualFold(scheme, bearerAuthorizationScheme) && isJWT(credential) {
+		// Check JWT
+		err = s.checkAgentJWT(ctx, verifier, partition, credential)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		return partition, nil
 	}
 
-	// Check if upload is authenticated
-	if partition.AuthorizationToken != authorizationSplit[1] {
-		logger.Error("Authorization token not equal to selected partition one")
+	// Get active tokens
+	tokens, err := s.dao.FindActiveTokensByPartitionID(partition.ID, time.Now())
+	// Check error
+	if err != nil {
+		return nil, err
+	}
 
-		return errors.NewUnauthorizedError("unauthorized")
+	// Check if upload is authenticated with one of the active tokens
+	for _, t := range tokens {
+		if t.Matches(credential) {
+			return partition, nil
+		}
 	}
 
-	return nil
+	logger.Error("Authorization token not equal to any active token of selected partition")
+
+	return nil, errors.NewUnauthorizedError("unauthorized")
 }
 
 func (s *service) GetAllPaginated(
@@ -149,10 +237,11 @@ func (s *service) GetAllPaginated(
 	projection *models.Projection,
 ) ([]*models.Partition, *pagination.PageOutput, error) {
 	// Check authorization
-	err := s.authorizationSvc.CheckAuthorized(
+	err := s.authorizationSvc.CheckAuthorizedOnResource(
 		ctx,
 		fmt.Sprintf("%s:List", mainAuthorizationPrefix),
 		"",
+		&authxmodels.Resource{Type: models.AuthorizationResourceType},
 	)
 	// Check error
 	if err != nil {
@@ -194,43 +283,61 @@ func (s *service) validateCreateInput(inp *models.CreateInput) error {
 		}
 	}
 
+	// Validate decision log outcome rule
+	_, err = models.ParseOutcomeRule(inp.DecisionLogOutcomeRule)
+	// Check error
+	if err != nil {
+		return errors.NewInvalidInputErrorWithError(err)
+	}
+
+	// Validate labels
+	_, err = buildLabels(inp.Labels)
+	// Check error
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (s *service) Create(ctx context.Context, inp *models.CreateInput) (*models.Partition, error) {
+func (s *service) Create(ctx context.Context, inp *models.CreateInput) (*models.Partition, *models.PartitionToken, error) {
 	// Get logger from context
 	logger := log.GetLoggerFromContext(ctx)
 
 	// Validate input
 	err := s.validateCreateInput(inp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// Build labels
+	labels, err := buildLabels(inp.Labels)
+	// Check error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Create partition object
+	obj := &models.Partition{
+		Name:                   inp.Name,
+		DecisionLogRetention:   inp.DecisionLogRetention,
+		StatusDataRetention:    inp.StatusDataRetention,
+		DecisionLogOutcomeRule: inp.DecisionLogOutcomeRule,
+		Description:            inp.Description,
+		Labels:                 labels,
+		Owners:                 inp.Owners,
 	}
 
 	// Check authorization
-	err = s.authorizationSvc.CheckAuthorized(
+	err = s.authorizationSvc.CheckAuthorizedOnResource(
 		ctx,
 		fmt.Sprintf("%s:Create", mainAuthorizationPrefix),
 		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, inp.Name),
+		obj.GetAuthorizationResource(),
 	)
 	// Check error
 	if err != nil {
-		return nil, err
-	}
-
-	// Create new uuid as "authorization token"
-	uuid, err := uuid.NewV4()
-	// Check error
-	if err != nil {
-		return nil, err
-	}
-
-	// Create partition object
-	obj := &models.Partition{
-		Name:                 inp.Name,
-		DecisionLogRetention: inp.DecisionLogRetention,
-		StatusDataRetention:  inp.StatusDataRetention,
-		AuthorizationToken:   uuid.String(),
+		return nil, nil, err
 	}
 
 	// Search if it already exists
@@ -238,24 +345,31 @@ func (s *service) Create(ctx context.Context, inp *models.CreateInput) (*models.
 	dbE, err := s.dao.FindByName(obj.Name, &models.Projection{ID: true})
 	// Check error
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	// Check if item already exists in database
 	if dbE != nil {
-		return nil, errors.NewConflictError(fmt.Sprintf("partition with name %s already exists", obj.Name))
+		return nil, nil, errors.NewConflictError(fmt.Sprintf("partition with name %s already exists", obj.Name))
+	}
+
+	// Build default token
+	tok, err := newToken("", models.DefaultTokenName, nil)
+	// Check error
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// Call dao
-	res, err := s.dao.Save(obj)
+	res, err := s.dao.Create(obj, tok)
 	// Check error
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Log
 	logger.Infof("Partition %s successfully created", res.Name)
 
-	return res, nil
+	return res, tok, nil
 }
 
 func (s *service) validateUpdateInput(inp *models.UpdateInput) error {
@@ -285,6 +399,23 @@ func (s *service) validateUpdateInput(inp *models.UpdateInput) error {
 		}
 	}
 
+	// Validate decision log outcome rule
+	if inp.DecisionLogOutcomeRule != nil {
+		// Try to parse rule
+		_, err := models.ParseOutcomeRule(*inp.DecisionLogOutcomeRule)
+		// Check error
+		if err != nil {
+			return errors.NewInvalidInputErrorWithError(err)
+		}
+	}
+
+	// Validate labels
+	_, err = buildLabels(inp.Labels)
+	// Check error
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -302,22 +433,19 @@ func (s *service) Update(ctx context.Context, inp *models.UpdateInput) (*models.
 	if err != nil {
 		return nil, err
 	}
-	// Check if exists
-	if res == nil {
-		return nil, errors.NewNotFoundError("partition not found")
-	}
 
-	// Check authorization
-	err = s.authorizationSvc.CheckAuthorized(
-		ctx,
-		fmt.Sprintf("%s:Update", mainAuthorizationPrefix),
-		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, res.Name),
-	)
+	// Check authorization before checking existence in order to not disclose existing partitions
+	err = s.checkPartitionAuthorized(ctx, "Update", inp.ID, res)
 	// Check error
 	if err != nil {
 		return nil, err
 	}
 
+	// Check if exists
+	if res == nil {
+		return nil, errors.NewNotFoundError("partition not found")
+	}
+
 	// Update only necessary fields
 
 	// Store if something changed
@@ -335,11 +463,45 @@ func (s *service) Update(ctx context.Context, inp *models.UpdateInput) (*models.
 		edited = true
 	}
 
+	// Check if decision log outcome rule is set
+	if inp.DecisionLogOutcomeRule != nil {
+		res.DecisionLogOutcomeRule = *inp.DecisionLogOutcomeRule
+		edited = true
+	}
+
+	// Check if description is set
+	if inp.Description != nil {
+		res.Description = *inp.Description
+		edited = true
+	}
+
+	// Check if labels are set
+	if inp.Labels != nil {
+		// Build labels
+		// Error is ignored because labels have already been validated
+		res.Labels, _ = buildLabels(inp.Labels)
+		edited = true
+	}
+
+	// Check if owners are set
+	if inp.Owners != nil {
+		res.Owners = inp.Owners
+		edited = true
+	}
+
 	// Check if nothing was edited
 	if !edited {
 		return res, nil
 	}
 
+	// Check authorization on updated partition
+	// This is done in order to not allow to move a partition out of the authorized labels or owners
+	err = s.checkPartitionAuthorized(ctx, "Update", res.ID, res)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
 	// Save
 	res, err = s.dao.Save(res)
 	// Check error
@@ -350,38 +512,372 @@ func (s *service) Update(ctx context.Context, inp *models.UpdateInput) (*models.
 	return res, nil
 }
 
+func (s *service) Delete(ctx context.Context, inp *models.DeleteInput) (*models.Partition, error) {
+	// Get logger from context
+	logger := log.GetLoggerFromContext(ctx)
+
+	// Validate input
+	err := s.validator.Struct(inp)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Find partition
+	res, err := s.dao.FindByID(inp.ID, nil)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check authorization before checking existence in order to not disclose existing partitions
+	err = s.checkPartitionAuthorized(ctx, "Delete", inp.ID, res)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if exists
+	if res == nil {
+		return nil, errors.NewNotFoundError("partition not found")
+	}
+
+	// Soft delete partition
+	// Uploads are refused from now on because partition can't be found anymore
+	res, err = s.dao.Delete(res)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Log
+	logger.Infof("Partition %s successfully deleted", res.Name)
+
+	return res, nil
+}
+
+func (s *service) Restore(ctx context.Context, inp *models.RestoreInput) (*models.Partition, error) {
+	// Get logger from context
+	logger := log.GetLoggerFromContext(ctx)
+
+	// Validate input
+	err := s.validator.Struct(inp)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Find deleted partition
+	res, err := s.dao.FindDeletedByID(inp.ID)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check authorization before checking existence in order to not disclose existing partitions
+	err = s.checkPartitionAuthorized(ctx, "Restore", inp.ID, res)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if exists
+	if res == nil {
+		return nil, errors.NewNotFoundError("deleted partition not found")
+	}
+
+	// Search if another partition have been created with the same name
+	dbE, err := s.dao.FindByName(res.Name, &models.Projection{ID: true})
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if item already exists in database
+	if dbE != nil {
+		return nil, errors.NewConflictError(fmt.Sprintf("partition with name %s already exists", res.Name))
+	}
+
+	// Restore partition
+	res, err = s.dao.Restore(res)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Log
+	logger.Infof("Partition %s successfully restored", res.Name)
+
+	return res, nil
+}
+
+func (s *service) Purge(ctx context.Context, inp *models.PurgeInput) (*models.Partition, error) {
+	// Validate input
+	err := s.validator.Struct(inp)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Find deleted partition
+	res, err := s.dao.FindDeletedByID(inp.ID)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check authorization before checking existence in order to not disclose existing partitions
+	err = s.checkPartitionAuthorized(ctx, "Purge", inp.ID, res)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if exists
+	if res == nil {
+		return nil, errors.NewNotFoundError("deleted partition not found")
+	}
+
+	// Create logger not linked to request as purge can be longer than request
+	logger := s.logger.WithField("task", "partition-purge").WithField("partition", res.Name)
+
+	// Purge in background
+	go func() {
+		// Purge
+		err := s.purgePartition(logger, res)
+		// Check error
+		if err != nil {
+			logger.Error(err)
+		}
+	}()
+
+	return res, nil
+}
+
+// purgePartition will remove permanently partition data and then partition itself.
+// Partition is removed last in order to be able to purge it again after a failure.
+func (s *service) purgePartition(logger log.Logger, partition *models.Partition) error {
+	// Only one purge at a time in order to avoid deleting the same rows concurrently
+	s.purgeMutex.Lock()
+	defer s.purgeMutex.Unlock()
+
+	// Check that partition is still deleted and not already purged
+	res, err := s.dao.FindDeletedByID(partition.ID)
+	// Check error
+	if err != nil {
+		return err
+	}
+	// Check if exists
+	if res == nil {
+		return nil
+	}
+
+	logger.Infof("Starting purge of partition %s", res.Name)
+
+	// Purge decision logs
+	err = s.decisionLogsSvc.PurgePartition(logger, res.ID)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Purge statuses
+	err = s.statusesSvc.PurgePartition(logger, res.ID)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Purge dead letters
+	err = s.deadLettersSvc.PurgePartition(logger, res.ID)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Purge partition and its tokens
+	err = s.dao.Purge(res.ID)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("Partition %s successfully purged", res.Name)
+
+	return nil
+}
+
 func (s *service) UnsecureFindByID(id string) (*models.Partition, error) {
 	return s.dao.FindByID(id, nil)
 }
 
 func (s *service) FindByID(ctx context.Context, id string, projection *models.Projection) (*models.Partition, error) {
+	// Attributes are needed for authorization
+	addAuthorizationProjection(projection)
+
+	// Find partition
+	res, err := s.dao.FindByID(id, projection)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO Change this to a better solution
 	// Check authorization
-	err := s.authorizationSvc.CheckAuthorized(
+	err = s.authorizationSvc.CheckAuthorizedOnResource(
 		ctx,
 		fmt.Sprintf("%s:FindByID", mainAuthorizationPrefix),
 		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id),
+		models.NewAuthorizationResource(id, res),
 	)
 	// Check error
 	if err != nil {
 		return nil, err
 	}
 
-	return s.dao.FindByID(id, projection)
+	return res, nil
 }
 
-func (s *service) GenerateOPAConfiguration(ctx context.Context, id string) (string, error) {
-	// Check authorization
-	err := s.authorizationSvc.CheckAuthorized(
+func (s *service) FindByIDs(ctx context.Context, ids []string, projection *models.Projection) ([]*models.Partition, []error) {
+	// Create results
+	res := make([]*models.Partition, len(ids))
+	errs := make([]error, len(ids))
+
+	// Id is needed to match partitions with ids and attributes are needed for authorization
+	addAuthorizationProjection(projection)
+
+	// Find partitions
+	list, err := s.dao.FindByIDs(ids, projection)
+	// Check error
+	if err != nil {
+		// Set error on all ids
+		for i := range ids {
+			errs[i] = err
+		}
+
+		return res, errs
+	}
+
+	// Index partitions by id
+	byID := make(map[string]*models.Partition, len(list))
+	// Loop over list
+	for _, it := range list {
+		byID[it.ID] = it
+	}
+	// Loop over ids in order to keep order
+	for i, id := range ids {
+		// Get partition
+		partition := byID[id]
+		// Check authorization
+		err := s.authorizationSvc.CheckAuthorizedOnResource(
+			ctx,
+			fmt.Sprintf("%s:FindByID", mainAuthorizationPrefix),
+			fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id),
+			models.NewAuthorizationResource(id, partition),
+		)
+		// Check error
+		if err != nil {
+			errs[i] = err
+
+			continue
+		}
+		// Save partition
+		res[i] = partition
+	}
+
+	return res, errs
+}
+
+func (s *service) FindAuthorizedIDs(ctx context.Context, id, name *string) ([]string, error) {
+	// Create filter
+	filter := &models.Filter{}
+	// Check if id filter is set
+	if id != nil {
+		filter.ID = &common.GenericFilter{Eq: *id}
+	}
+	// Check if name filter is set
+	if name != nil {
+		filter.Name = &common.GenericFilter{Eq: *name}
+	}
+
+	// Check if all partitions can be read
+	// This avoids one authorization request per partition
+	allAuthorized, err := s.authorizationSvc.IsAuthorizedOnResource(
 		ctx,
-		fmt.Sprintf("%s:GenerateOPAConfiguration", mainAuthorizationPrefix),
-		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id),
+		fmt.Sprintf("%s:FindAll", mainAuthorizationPrefix),
+		"",
+		&authxmodels.Resource{Type: models.AuthorizationResourceType},
 	)
 	// Check error
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	// Check if all partitions are authorized
+	if allAuthorized {
+		// Find partitions ids
+		list, err := s.dao.FindAll(filter, &models.Projection{ID: true}, 0)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		return getPartitionIDs(list), nil
+	}
+
+	// Find partitions with attributes needed for authorization
+	// One more partition is requested in order to know if there are too many partitions to check
+	list, err := s.dao.FindAll(
+		filter,
+		&models.Projection{ID: true, Name: true, Labels: true, Owners: true},
+		maxAuthorizationChecks+1,
+	)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if there are too many partitions
+	if len(list) > maxAuthorizationChecks {
+		return nil, errors.NewInvalidInputError(
+			fmt.Sprintf("more than %d partitions must be checked, filter on a partition", maxAuthorizationChecks),
+		)
+	}
+
+	// Create result
+	res := make([]*models.Partition, 0, len(list))
+	// Loop over partitions
+	for _, it := range list {
+		// Check authorization
+		authorized, err := s.authorizationSvc.IsAuthorizedOnResource(
+			ctx,
+			fmt.Sprintf("%s:FindByID", mainAuthorizationPrefix),
+			fmt.Sprintf("%s:%s", mainAuthorizationPrefix, it.ID),
+			it.GetAuthorizationResource(),
+		)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Keep only authorized partitions
+		if authorized {
+			res = append(res, it)
+		}
+	}
+
+	return getPartitionIDs(res), nil
+}
+
+// getPartitionIDs will return ids of partitions.
+func getPartitionIDs(list []*models.Partition) []string {
+	// Create result
+	res := make([]string, 0, len(list))
+	// Loop over partitions
+	for _, it := range list {
+		res = append(res, it.ID)
 	}
 
+	return res
+}
+
+func (s *service) GenerateOPAConfiguration(ctx context.Context, id string) (string, error) {
 	// Get partition from id
 	partition, err := s.dao.FindByID(id, nil)
 	// Check error
@@ -389,6 +885,23 @@ func (s *service) GenerateOPAConfiguration(ctx context.Context, id string) (stri
 		return "", err
 	}
 
+	// Check authorization
+	err = s.authorizationSvc.CheckAuthorizedOnResource(
+		ctx,
+		fmt.Sprintf("%s:GenerateOPAConfiguration", mainAuthorizationPrefix),
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id),
+		models.NewAuthorizationResource(id, partition),
+	)
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	// Check if partition exists
+	if partition == nil {
+		return "", errors.NewNotFoundError("partition not found")
+	}
+
 	// Get configuration
 	cfg := s.cfgManager.GetConfig()
 	// Create service url
@@ -399,6 +912,14 @@ func (s *service) GenerateOPAConfiguration(ctx context.Context, id string) (stri
 		Partition:  partition,
 		ServiceURL: serviceURL,
 	}
+	// Check if agents must use client certificates
+	if cfg.Center.AgentCertAuthentication != nil && len(cfg.Center.AgentCertAuthentication.Partitions[partition.Name]) != 0 {
+		data.ClientTLS = true
+	}
+	// Check if agents must use OAuth2 client credentials
+	if cfg.Center.AgentJWTAuthentication != nil && cfg.Center.AgentJWTAuthentication.TokenURL != "" {
+		data.OAuth2 = cfg.Center.AgentJWTAuthentication
+	}
 
 	// Generate configuration
 	// Generate template in buffer
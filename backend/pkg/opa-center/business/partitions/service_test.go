@@ -0,0 +1,576 @@
+//+build unit
+
+package partitions
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	amocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authorization/mocks"
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	daosmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/daos/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_service_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authoSvcMock := amocks.NewMockService(ctrl)
+	authoSvcMock.EXPECT().
+		CheckAuthorizedOnResource(gomock.Any(), "partitions:Create", "partitions:fake", gomock.Any()).
+		Return(nil)
+
+	daoMock := daosmocks.NewMockDao(ctrl)
+	daoMock.EXPECT().FindByName("fake", gomock.Any()).Return(nil, nil)
+	// Partition and token must be created together
+	daoMock.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(p *models.Partition, tok *models.PartitionToken) (*models.Partition, error) {
+			assert.Equal(t, "fake", p.Name)
+			assert.Equal(t, models.DefaultTokenName, tok.Name)
+			assert.True(t, tok.Matches(tok.Value))
+
+			p.ID = "p1"
+			tok.PartitionID = p.ID
+
+			return p, nil
+		})
+
+	s := &service{dao: daoMock, authorizationSvc: authoSvcMock, validator: validator.New()}
+	ctx := log.SetLoggerToContext(context.TODO(), log.NewLogger())
+
+	p, tok, err := s.Create(ctx, &models.CreateInput{Name: "fake"})
+	assert.NoError(t, err)
+	assert.Equal(t, "p1", p.ID)
+	assert.Equal(t, "p1", tok.PartitionID)
+	assert.NotEmpty(t, tok.Value)
+}
+
+func Test_service_Create_transactionFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authoSvcMock := amocks.NewMockService(ctrl)
+	authoSvcMock.EXPECT().
+		CheckAuthorizedOnResource(gomock.Any(), "partitions:Create", "partitions:fake", gomock.Any()).
+		Return(nil)
+
+	daoMock := daosmocks.NewMockDao(ctrl)
+	daoMock.EXPECT().FindByName("fake", gomock.Any()).Return(nil, nil)
+	daoMock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, errors.NewInternalServerError("fake"))
+
+	s := &service{dao: daoMock, authorizationSvc: authoSvcMock, validator: validator.New()}
+	ctx := log.SetLoggerToContext(context.TODO(), log.NewLogger())
+
+	p, tok, err := s.Create(ctx, &models.CreateInput{Name: "fake"})
+	assert.Error(t, err)
+	assert.Nil(t, p)
+	assert.Nil(t, tok)
+}
+
+func Test_service_FindAuthorizedIDs(t *testing.T) {
+	name := "fake"
+	p1 := &models.Partition{Base: database.Base{ID: "p1"}, Name: "p1"}
+	p2 := &models.Partition{Base: database.Base{ID: "p2"}, Name: "p2"}
+	tooMany := make([]*models.Partition, maxAuthorizationChecks+1)
+	allResource := &authxmodels.Resource{Type: models.AuthorizationResourceType}
+
+	tests := []struct {
+		name          string
+		allAuthorized bool
+		list          []*models.Partition
+		authorized    map[string]bool
+		want          []string
+		wantErr       bool
+	}{
+		{
+			name:          "all partitions authorized with one request",
+			allAuthorized: true,
+			list:          []*models.Partition{p1, p2},
+			want:          []string{"p1", "p2"},
+		},
+		{
+			name:       "partitions checked one by one",
+			list:       []*models.Partition{p1, p2},
+			authorized: map[string]bool{"p1": false, "p2": true},
+			want:       []string{"p2"},
+		},
+		{
+			name:       "no authorized partition",
+			list:       []*models.Partition{p1},
+			authorized: map[string]bool{"p1": false},
+			want:       []string{},
+		},
+		{
+			name:    "too many partitions to check",
+			list:    tooMany,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authoSvcMock := amocks.NewMockService(ctrl)
+			authoSvcMock.EXPECT().
+				IsAuthorizedOnResource(gomock.Any(), "partitions:FindAll", "", allResource).
+				Return(tt.allAuthorized, nil)
+			// Loop over expected checks
+			for id, authorized := range tt.authorized {
+				authoSvcMock.EXPECT().
+					IsAuthorizedOnResource(gomock.Any(), "partitions:FindByID", "partitions:"+id, gomock.Any()).
+					Return(authorized, nil)
+			}
+
+			daoMock := daosmocks.NewMockDao(ctrl)
+			// Filter must be kept
+			wantFilter := &models.Filter{Name: &common.GenericFilter{Eq: name}}
+			if tt.allAuthorized {
+				daoMock.EXPECT().FindAll(wantFilter, &models.Projection{ID: true}, 0).Return(tt.list, nil)
+			} else {
+				daoMock.EXPECT().
+					FindAll(wantFilter, &models.Projection{ID: true, Name: true, Labels: true, Owners: true}, maxAuthorizationChecks+1).
+					Return(tt.list, nil)
+			}
+
+			s := &service{dao: daoMock, authorizationSvc: authoSvcMock}
+
+			got, err := s.FindAuthorizedIDs(context.TODO(), nil, &name)
+			if tt.wantErr {
+				assertStatusCode(t, http.StatusBadRequest, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_service_Delete(t *testing.T) {
+	partition := &models.Partition{Base: database.Base{ID: "p1"}, Name: "fake"}
+
+	tests := []struct {
+		name           string
+		partition      *models.Partition
+		wantResource   string
+		wantResObj     *authxmodels.Resource
+		authorizedErr  error
+		wantStatusCode int
+	}{
+		{
+			name:         "authorized",
+			partition:    partition,
+			wantResource: "partitions:fake",
+			wantResObj:   partition.GetAuthorizationResource(),
+		},
+		{
+			name:           "forbidden",
+			partition:      partition,
+			wantResource:   "partitions:fake",
+			wantResObj:     partition.GetAuthorizationResource(),
+			authorizedErr:  errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "not found and authorized",
+			wantResource:   "partitions:p1",
+			wantResObj:     &authxmodels.Resource{Type: "partitions", ID: "p1"},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "not found and forbidden must not disclose existence",
+			wantResource:   "partitions:p1",
+			wantResObj:     &authxmodels.Resource{Type: "partitions", ID: "p1"},
+			authorizedErr:  errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			daoMock := daosmocks.NewMockDao(ctrl)
+			daoMock.EXPECT().FindByID("p1", nil).Return(tt.partition, nil)
+			if tt.wantStatusCode == 0 {
+				daoMock.EXPECT().Delete(tt.partition).Return(tt.partition, nil)
+			}
+
+			authoSvcMock := amocks.NewMockService(ctrl)
+			authoSvcMock.EXPECT().
+				CheckAuthorizedOnResource(gomock.Any(), "partitions:Delete", tt.wantResource, tt.wantResObj).
+				Return(tt.authorizedErr)
+
+			s := &service{dao: daoMock, authorizationSvc: authoSvcMock, validator: validator.New()}
+			ctx := log.SetLoggerToContext(context.TODO(), log.NewLogger())
+
+			got, err := s.Delete(ctx, &models.DeleteInput{ID: "p1"})
+			if tt.wantStatusCode != 0 {
+				assertStatusCode(t, tt.wantStatusCode, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.partition, got)
+		})
+	}
+}
+
+func Test_service_Restore(t *testing.T) {
+	partition := &models.Partition{Base: database.Base{ID: "p1"}, Name: "fake"}
+
+	tests := []struct {
+		name           string
+		partition      *models.Partition
+		existing       *models.Partition
+		wantResource   string
+		wantResObj     *authxmodels.Resource
+		authorizedErr  error
+		wantStatusCode int
+	}{
+		{
+			name:         "authorized",
+			partition:    partition,
+			wantResource: "partitions:fake",
+			wantResObj:   partition.GetAuthorizationResource(),
+		},
+		{
+			name:           "name already used by another partition",
+			partition:      partition,
+			existing:       &models.Partition{Base: database.Base{ID: "p2"}},
+			wantResource:   "partitions:fake",
+			wantResObj:     partition.GetAuthorizationResource(),
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "forbidden",
+			partition:      partition,
+			wantResource:   "partitions:fake",
+			wantResObj:     partition.GetAuthorizationResource(),
+			authorizedErr:  errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "not found and authorized",
+			wantResource:   "partitions:p1",
+			wantResObj:     &authxmodels.Resource{Type: "partitions", ID: "p1"},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "not found and forbidden must not disclose existence",
+			wantResource:   "partitions:p1",
+			wantResObj:     &authxmodels.Resource{Type: "partitions", ID: "p1"},
+			authorizedErr:  errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			daoMock := daosmocks.NewMockDao(ctrl)
+			daoMock.EXPECT().FindDeletedByID("p1").Return(tt.partition, nil)
+			if tt.partition != nil && tt.authorizedErr == nil {
+				daoMock.EXPECT().FindByName("fake", gomock.Any()).Return(tt.existing, nil)
+			}
+			if tt.wantStatusCode == 0 {
+				daoMock.EXPECT().Restore(tt.partition).Return(tt.partition, nil)
+			}
+
+			authoSvcMock := amocks.NewMockService(ctrl)
+			authoSvcMock.EXPECT().
+				CheckAuthorizedOnResource(gomock.Any(), "partitions:Restore", tt.wantResource, tt.wantResObj).
+				Return(tt.authorizedErr)
+
+			s := &service{dao: daoMock, authorizationSvc: authoSvcMock, validator: validator.New()}
+			ctx := log.SetLoggerToContext(context.TODO(), log.NewLogger())
+
+			got, err := s.Restore(ctx, &models.RestoreInput{ID: "p1"})
+			if tt.wantStatusCode != 0 {
+				assertStatusCode(t, tt.wantStatusCode, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.partition, got)
+		})
+	}
+}
+
+func Test_service_Purge(t *testing.T) {
+	partition := &models.Partition{Base: database.Base{ID: "p1"}, Name: "fake"}
+
+	tests := []struct {
+		name           string
+		partition      *models.Partition
+		wantResource   string
+		wantResObj     *authxmodels.Resource
+		authorizedErr  error
+		wantStatusCode int
+	}{
+		{
+			name:         "authorized",
+			partition:    partition,
+			wantResource: "partitions:fake",
+			wantResObj:   partition.GetAuthorizationResource(),
+		},
+		{
+			name:           "forbidden",
+			partition:      partition,
+			wantResource:   "partitions:fake",
+			wantResObj:     partition.GetAuthorizationResource(),
+			authorizedErr:  errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "not found and authorized",
+			wantResource:   "partitions:p1",
+			wantResObj:     &authxmodels.Resource{Type: "partitions", ID: "p1"},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "not found and forbidden must not disclose existence",
+			wantResource:   "partitions:p1",
+			wantResObj:     &authxmodels.Resource{Type: "partitions", ID: "p1"},
+			authorizedErr:  errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			daoMock := daosmocks.NewMockDao(ctrl)
+			daoMock.EXPECT().FindDeletedByID("p1").Return(tt.partition, nil)
+
+			retentionSvcMock := mocks.NewMockRetentionService(ctrl)
+			deadLettersSvcMock := mocks.NewMockDeadLettersService(ctrl)
+
+			// Channel closed when background purge is done
+			done := make(chan struct{})
+			if tt.wantStatusCode == 0 {
+				daoMock.EXPECT().FindDeletedByID("p1").Return(tt.partition, nil)
+				retentionSvcMock.EXPECT().PurgePartition(gomock.Any(), "p1").Return(nil).Times(2)
+				deadLettersSvcMock.EXPECT().PurgePartition(gomock.Any(), "p1").Return(nil)
+				daoMock.EXPECT().Purge("p1").DoAndReturn(func(string) error {
+					close(done)
+
+					return nil
+				})
+			}
+
+			authoSvcMock := amocks.NewMockService(ctrl)
+			authoSvcMock.EXPECT().
+				CheckAuthorizedOnResource(gomock.Any(), "partitions:Purge", tt.wantResource, tt.wantResObj).
+				Return(tt.authorizedErr)
+
+			s := &service{
+				dao:              daoMock,
+				authorizationSvc: authoSvcMock,
+				validator:        validator.New(),
+				decisionLogsSvc:  retentionSvcMock,
+				statusesSvc:      retentionSvcMock,
+				deadLettersSvc:   deadLettersSvcMock,
+				logger:           log.NewLogger(),
+			}
+
+			got, err := s.Purge(context.TODO(), &models.PurgeInput{ID: "p1"})
+			if tt.wantStatusCode != 0 {
+				assertStatusCode(t, tt.wantStatusCode, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.partition, got)
+
+			// Wait for background purge
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("partition purge not done")
+			}
+		})
+	}
+}
+
+func Test_service_purgePartition(t *testing.T) {
+	partition := &models.Partition{Base: database.Base{ID: "p1"}, Name: "fake"}
+
+	tests := []struct {
+		name      string
+		deleted   *models.Partition
+		purgeErr  error
+		wantPurge bool
+		wantErr   bool
+	}{
+		{
+			name:      "purge data and then partition",
+			deleted:   partition,
+			wantPurge: true,
+		},
+		{
+			name: "partition restored or already purged",
+		},
+		{
+			name:     "partition kept when data purge fails",
+			deleted:  partition,
+			purgeErr: errors.NewInternalServerError("fake"),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			daoMock := daosmocks.NewMockDao(ctrl)
+			daoMock.EXPECT().FindDeletedByID("p1").Return(tt.deleted, nil)
+
+			decisionLogsSvcMock := mocks.NewMockRetentionService(ctrl)
+			statusesSvcMock := mocks.NewMockRetentionService(ctrl)
+			deadLettersSvcMock := mocks.NewMockDeadLettersService(ctrl)
+			if tt.deleted != nil {
+				decisionLogsSvcMock.EXPECT().PurgePartition(gomock.Any(), "p1").Return(tt.purgeErr)
+			}
+			if tt.wantPurge {
+				gomock.InOrder(
+					statusesSvcMock.EXPECT().PurgePartition(gomock.Any(), "p1").Return(nil),
+					deadLettersSvcMock.EXPECT().PurgePartition(gomock.Any(), "p1").Return(nil),
+					daoMock.EXPECT().Purge("p1").Return(nil),
+				)
+			}
+
+			s := &service{
+				dao:             daoMock,
+				decisionLogsSvc: decisionLogsSvcMock,
+				statusesSvc:     statusesSvcMock,
+				deadLettersSvc:  deadLettersSvcMock,
+			}
+
+			err := s.purgePartition(log.NewLogger(), partition)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_service_Update(t *testing.T) {
+	description := "new description"
+
+	tests := []struct {
+		name           string
+		inp            *models.UpdateInput
+		partition      *models.Partition
+		firstErr       error
+		secondErr      error
+		wantSecond     bool
+		wantResObj     *authxmodels.Resource
+		wantStatusCode int
+	}{
+		{
+			name: "authorized before and after update",
+			inp:  &models.UpdateInput{ID: "p1", Owners: []string{"team-b"}},
+			partition: &models.Partition{
+				Base: database.Base{ID: "p1"}, Name: "fake", Owners: models.Owners{"team-a"},
+			},
+			wantSecond: true,
+			wantResObj: &authxmodels.Resource{
+				Type: "partitions", ID: "p1", Name: "fake",
+				Attributes: map[string]interface{}{"labels": models.Labels{}, "owners": models.Owners{"team-b"}},
+			},
+		},
+		{
+			name: "updated partition forbidden",
+			inp:  &models.UpdateInput{ID: "p1", Description: &description},
+			partition: &models.Partition{
+				Base: database.Base{ID: "p1"}, Name: "fake",
+			},
+			wantSecond: true,
+			wantResObj: &authxmodels.Resource{
+				Type: "partitions", ID: "p1", Name: "fake",
+				Attributes: map[string]interface{}{"labels": models.Labels{}, "owners": models.Owners{}},
+			},
+			secondErr:      errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "forbidden",
+			inp:            &models.UpdateInput{ID: "p1", Description: &description},
+			partition:      &models.Partition{Base: database.Base{ID: "p1"}, Name: "fake"},
+			firstErr:       errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "not found and forbidden must not disclose existence",
+			inp:            &models.UpdateInput{ID: "p1", Description: &description},
+			firstErr:       errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "not found",
+			inp:            &models.UpdateInput{ID: "p1", Description: &description},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			daoMock := daosmocks.NewMockDao(ctrl)
+			daoMock.EXPECT().FindByID("p1", nil).Return(tt.partition, nil)
+
+			authoSvcMock := amocks.NewMockService(ctrl)
+			// Resource of partition before update
+			first := models.NewAuthorizationResource("p1", tt.partition)
+			firstCall := authoSvcMock.EXPECT().
+				CheckAuthorizedOnResource(gomock.Any(), "partitions:Update", gomock.Any(), first).
+				Return(tt.firstErr)
+			if tt.wantSecond {
+				authoSvcMock.EXPECT().
+					CheckAuthorizedOnResource(gomock.Any(), "partitions:Update", "partitions:fake", tt.wantResObj).
+					Return(tt.secondErr).
+					After(firstCall)
+			}
+			if tt.wantStatusCode == 0 {
+				daoMock.EXPECT().Save(tt.partition).Return(tt.partition, nil)
+			}
+
+			s := &service{dao: daoMock, authorizationSvc: authoSvcMock, validator: validator.New()}
+
+			got, err := s.Update(context.TODO(), tt.inp)
+			if tt.wantStatusCode != 0 {
+				assertStatusCode(t, tt.wantStatusCode, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.partition, got)
+		})
+	}
+}
@@ -2,12 +2,34 @@ package partitions
 
 import "text/template"
 
+// Secrets are never displayed in configuration, so OPA must read them from environment variables:
+// OPA_CENTER_CLIENT_CERT and OPA_CENTER_CLIENT_KEY for client certificate files,
+// OPA_CENTER_CLIENT_ID and OPA_CENTER_CLIENT_SECRET for OAuth2 client credentials
+// or OPA_CENTER_TOKEN for partition tokens.
 const opaCfgTemplateString = `
 services:
   opacenter-{{ .Partition.Name }}:
     url: {{ .ServiceURL }}
-    headers:
-      Authorization: Token {{ .Partition.AuthorizationToken }}
+    credentials:
+{{- if .ClientTLS }}
+      client_tls:
+        cert: ${OPA_CENTER_CLIENT_CERT}
+        private_key: ${OPA_CENTER_CLIENT_KEY}
+{{- else if .OAuth2 }}
+      oauth2:
+        token_url: {{ .OAuth2.TokenURL }}
+        client_id: ${OPA_CENTER_CLIENT_ID}
+        client_secret: ${OPA_CENTER_CLIENT_SECRET}
+{{- if .OAuth2.Scopes }}
+        scopes:
+{{- range .OAuth2.Scopes }}
+          - {{ . }}
+{{- end }}
+{{- end }}
+{{- else }}
+      bearer:
+        token: ${OPA_CENTER_TOKEN}
+{{- end }}
 
 decision_logs:
   service: opacenter-{{ .Partition.Name }}
@@ -0,0 +1,350 @@
+package partitions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+)
+
+// defaultRotationOverlap is the time during which the rotated token is still accepted when no overlap is given.
+const defaultRotationOverlap = 24 * time.Hour
+
+func (s *service) GetTokens(ctx context.Context, partitionID string) ([]*models.PartitionToken, error) {
+	// Get partition and check authorization
+	_, err := s.getPartitionAuthorized(ctx, partitionID, "ListTokens")
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return s.dao.FindTokensByPartitionID(partitionID)
+}
+
+func (s *service) FindTokenByID(ctx context.Context, id string) (*models.PartitionToken, error) {
+	// Get token and check authorization
+	token, _, err := s.getTokenAuthorized(ctx, id, "FindTokenByID")
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (s *service) CreateToken(ctx context.Context, inp *models.CreateTokenInput) (*models.PartitionToken, error) {
+	// Get logger from context
+	logger := log.GetLoggerFromContext(ctx)
+
+	// Validate input
+	err := s.validator.Struct(inp)
+	// Check error
+	if err != nil {
+		return nil, errors.NewInvalidInputErrorWithError(err)
+	}
+
+	// Parse expiration date
+	expiresAt, err := parseTokenExpiration(inp.ExpiresAt)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get partition and check authorization
+	partition, err := s.getPartitionAuthorized(ctx, inp.PartitionID, "CreateToken")
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get active tokens
+	tokens, err := s.dao.FindActiveTokensByPartitionID(partition.ID, time.Now())
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check that name isn't already used by an active token
+	for _, t := range tokens {
+		if t.Name == inp.Name {
+			return nil, errors.NewConflictError(fmt.Sprintf("token with name %s already exists", inp.Name))
+		}
+	}
+
+	// Create token
+	res, err := s.createToken(partition.ID, inp.Name, expiresAt)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Log
+	logger.Infof("Token %s successfully created for partition %s", res.Name, partition.Name)
+
+	return res, nil
+}
+
+func (s *service) RevokeToken(ctx context.Context, inp *models.RevokeTokenInput) (*models.PartitionToken, error) {
+	// Get logger from context
+	logger := log.GetLoggerFromContext(ctx)
+
+	// Validate input
+	err := s.validator.Struct(inp)
+	// Check error
+	if err != nil {
+		return nil, errors.NewInvalidInputErrorWithError(err)
+	}
+
+	// Get token and check authorization
+	token, partition, err := s.getTokenAuthorized(ctx, inp.ID, "RevokeToken")
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if exists
+	if token == nil {
+		return nil, errors.NewNotFoundError("token not found")
+	}
+
+	// Check if token is already revoked
+	if token.RevokedAt != nil {
+		return token, nil
+	}
+
+	// Revoke
+	now := time.Now()
+	token.RevokedAt = &now
+	// Save
+	token, err = s.dao.SaveToken(token)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Log
+	logger.Infof("Token %s successfully revoked for partition %s", token.Name, partition.Name)
+
+	return token, nil
+}
+
+func (s *service) RotateToken(ctx context.Context, inp *models.RotateTokenInput) (*models.PartitionToken, error) {
+	// Get logger from context
+	logger := log.GetLoggerFromContext(ctx)
+
+	// Validate input
+	err := s.validator.Struct(inp)
+	// Check error
+	if err != nil {
+		return nil, errors.NewInvalidInputErrorWithError(err)
+	}
+
+	// Parse overlap duration
+	overlap := defaultRotationOverlap
+	// Check if overlap is set
+	if inp.OverlapDuration != nil {
+		overlap, err = time.ParseDuration(*inp.OverlapDuration)
+		// Check error
+		if err != nil {
+			return nil, errors.NewInvalidInputErrorWithError(err)
+		}
+		// Check that overlap isn't negative
+		if overlap < 0 {
+			return nil, errors.NewInvalidInputError("overlap duration must be positive")
+		}
+	}
+
+	// Parse expiration date
+	expiresAt, err := parseTokenExpiration(inp.ExpiresAt)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get token and check authorization
+	token, partition, err := s.getTokenAuthorized(ctx, inp.ID, "RotateToken")
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if exists
+	if token == nil {
+		return nil, errors.NewNotFoundError("token not found")
+	}
+
+	// Get now
+	now := time.Now()
+	// Check that token can be rotated
+	if !token.IsActive(now) {
+		return nil, errors.NewInvalidInputError("token is revoked or expired")
+	}
+
+	// Create new token with the same name
+	res, err := s.createToken(partition.ID, token.Name, expiresAt)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Expire old token at the end of the overlap window
+	overlapEnd := now.Add(overlap)
+	// Check if token doesn't already expire before
+	if token.ExpiresAt == nil || token.ExpiresAt.After(overlapEnd) {
+		token.ExpiresAt = &overlapEnd
+		// Save
+		_, err = s.dao.SaveToken(token)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Log
+	logger.Infof("Token %s successfully rotated for partition %s", res.Name, partition.Name)
+
+	return res, nil
+}
+
+func (s *service) createToken(partitionID, name string, expiresAt *time.Time) (*models.PartitionToken, error) {
+	// Build token
+	tok, err := newToken(partitionID, name, expiresAt)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Save token, value is kept in returned object in order to be displayed once
+	return s.dao.SaveToken(tok)
+}
+
+// newToken will generate a token with a new value.
+func newToken(partitionID, name string, expiresAt *time.Time) (*models.PartitionToken, error) {
+	// Generate token value
+	value, err := models.GenerateTokenValue()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Create token
+	tok := &models.PartitionToken{
+		PartitionID: partitionID,
+		Name:        name,
+		ExpiresAt:   expiresAt,
+	}
+	// Store hashed value
+	err = tok.SetValue(value)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return tok, nil
+}
+
+func (s *service) getPartitionAuthorized(ctx context.Context, partitionID, action string) (*models.Partition, error) {
+	// Find partition
+	partition, err := s.dao.FindByID(partitionID, &models.Projection{ID: true, Name: true, Labels: true, Owners: true})
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check authorization before checking existence in order to not disclose existing partitions
+	err = s.checkPartitionAuthorized(ctx, action, partitionID, partition)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if exists
+	if partition == nil {
+		return nil, errors.NewNotFoundError("partition not found")
+	}
+
+	return partition, nil
+}
+
+// getTokenAuthorized will find token and check authorization on its partition.
+// Authorization is checked before checking existence in order to not disclose existing tokens.
+// Token id is used as resource when token doesn't exist.
+func (s *service) getTokenAuthorized(
+	ctx context.Context,
+	id, action string,
+) (*models.PartitionToken, *models.Partition, error) {
+	// Find token
+	token, err := s.dao.FindTokenByID(id)
+	// Check error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check if exists
+	if token == nil {
+		// Check authorization without partition
+		err = s.authorizationSvc.CheckAuthorizedOnResource(
+			ctx,
+			fmt.Sprintf("%s:%s", mainAuthorizationPrefix, action),
+			fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id),
+			&authxmodels.Resource{
+				Type:       models.AuthorizationResourceType,
+				Attributes: map[string]interface{}{"token_id": id},
+			},
+		)
+		// Check error
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return nil, nil, nil
+	}
+
+	// Get partition and check authorization
+	partition, err := s.getPartitionAuthorized(ctx, token.PartitionID, action)
+	// Check error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return token, partition, nil
+}
+
+// checkPartitionAuthorized will check authorization of an action on a partition that may not exist.
+// Partition id is used as resource when partition doesn't exist.
+func (s *service) checkPartitionAuthorized(ctx context.Context, action, partitionID string, partition *models.Partition) error {
+	// Get resource
+	resource := fmt.Sprintf("%s:%s", mainAuthorizationPrefix, partitionID)
+	// Check if partition exists
+	if partition != nil {
+		resource = fmt.Sprintf("%s:%s", mainAuthorizationPrefix, partition.Name)
+	}
+
+	return s.authorizationSvc.CheckAuthorizedOnResource(
+		ctx,
+		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, action),
+		resource,
+		models.NewAuthorizationResource(partitionID, partition),
+	)
+}
+
+func parseTokenExpiration(expiresAt *string) (*time.Time, error) {
+	// Check if expiration is set
+	if expiresAt == nil || *expiresAt == "" {
+		return nil, nil
+	}
+
+	// Parse date
+	res, err := time.Parse(time.RFC3339, *expiresAt)
+	// Check error
+	if err != nil {
+		return nil, errors.NewInvalidInputErrorWithError(err)
+	}
+	// Check that date is in the future
+	if !res.After(time.Now()) {
+		return nil, errors.NewInvalidInputError("expiration date must be in the future")
+	}
+
+	return &res, nil
+}
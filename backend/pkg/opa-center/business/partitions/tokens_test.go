@@ -0,0 +1,247 @@
+//+build unit
+
+package partitions
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	amocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authorization/mocks"
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	daosmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/daos/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// assertStatusCode will check that error is a project error with status code.
+func assertStatusCode(t *testing.T, statusCode int, err error) {
+	// nolint: errorlint // Ignore this because the aim is to catch project error at first level
+	err2, ok := err.(errors.Error)
+	if assert.True(t, ok, "error %v isn't a project error", err) {
+		assert.Equal(t, statusCode, err2.StatusCode())
+	}
+}
+
+func Test_service_getPartitionAuthorized(t *testing.T) {
+	partition := &models.Partition{Base: database.Base{ID: "p1"}, Name: "fake"}
+
+	tests := []struct {
+		name           string
+		partition      *models.Partition
+		wantResource   string
+		wantResObj     *authxmodels.Resource
+		authorizedErr  error
+		wantStatusCode int
+	}{
+		{
+			name:         "authorized",
+			partition:    partition,
+			wantResource: "partitions:fake",
+			wantResObj:   partition.GetAuthorizationResource(),
+		},
+		{
+			name:           "forbidden",
+			partition:      partition,
+			wantResource:   "partitions:fake",
+			wantResObj:     partition.GetAuthorizationResource(),
+			authorizedErr:  errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "not found and authorized",
+			wantResource:   "partitions:p1",
+			wantResObj:     &authxmodels.Resource{Type: "partitions", ID: "p1"},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "not found and forbidden must not disclose existence",
+			wantResource:   "partitions:p1",
+			wantResObj:     &authxmodels.Resource{Type: "partitions", ID: "p1"},
+			authorizedErr:  errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			daoMock := daosmocks.NewMockDao(ctrl)
+			daoMock.EXPECT().FindByID("p1", gomock.Any()).Return(tt.partition, nil)
+
+			authoSvcMock := amocks.NewMockService(ctrl)
+			authoSvcMock.EXPECT().
+				CheckAuthorizedOnResource(gomock.Any(), "partitions:ListTokens", tt.wantResource, tt.wantResObj).
+				Return(tt.authorizedErr)
+
+			s := &service{dao: daoMock, authorizationSvc: authoSvcMock}
+
+			got, err := s.getPartitionAuthorized(context.TODO(), "p1", "ListTokens")
+			if tt.wantStatusCode != 0 {
+				assertStatusCode(t, tt.wantStatusCode, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.partition, got)
+		})
+	}
+}
+
+func Test_service_FindTokenByID(t *testing.T) {
+	partition := &models.Partition{Base: database.Base{ID: "p1"}, Name: "fake"}
+	token := &models.PartitionToken{Base: database.Base{ID: "t1"}, PartitionID: "p1"}
+	missingResObj := &authxmodels.Resource{Type: "partitions", Attributes: map[string]interface{}{"token_id": "t1"}}
+
+	tests := []struct {
+		name           string
+		token          *models.PartitionToken
+		wantResource   string
+		wantResObj     *authxmodels.Resource
+		authorizedErr  error
+		want           *models.PartitionToken
+		wantStatusCode int
+	}{
+		{
+			name:         "authorized",
+			token:        token,
+			wantResource: "partitions:fake",
+			wantResObj:   partition.GetAuthorizationResource(),
+			want:         token,
+		},
+		{
+			name:           "forbidden",
+			token:          token,
+			wantResource:   "partitions:fake",
+			wantResObj:     partition.GetAuthorizationResource(),
+			authorizedErr:  errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:         "not found and authorized",
+			wantResource: "partitions:t1",
+			wantResObj:   missingResObj,
+		},
+		{
+			name:           "not found and forbidden must not disclose existence",
+			wantResource:   "partitions:t1",
+			wantResObj:     missingResObj,
+			authorizedErr:  errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			daoMock := daosmocks.NewMockDao(ctrl)
+			daoMock.EXPECT().FindTokenByID("t1").Return(tt.token, nil)
+			if tt.token != nil {
+				daoMock.EXPECT().FindByID("p1", gomock.Any()).Return(partition, nil)
+			}
+
+			authoSvcMock := amocks.NewMockService(ctrl)
+			authoSvcMock.EXPECT().
+				CheckAuthorizedOnResource(gomock.Any(), "partitions:FindTokenByID", tt.wantResource, tt.wantResObj).
+				Return(tt.authorizedErr)
+
+			s := &service{dao: daoMock, authorizationSvc: authoSvcMock}
+
+			got, err := s.FindTokenByID(context.TODO(), "t1")
+			if tt.wantStatusCode != 0 {
+				assertStatusCode(t, tt.wantStatusCode, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_service_RevokeToken(t *testing.T) {
+	partition := &models.Partition{Base: database.Base{ID: "p1"}, Name: "fake"}
+	missingResObj := &authxmodels.Resource{Type: "partitions", Attributes: map[string]interface{}{"token_id": "t1"}}
+
+	tests := []struct {
+		name           string
+		token          *models.PartitionToken
+		wantResource   string
+		wantResObj     *authxmodels.Resource
+		authorizedErr  error
+		wantStatusCode int
+	}{
+		{
+			name:         "revoked",
+			token:        &models.PartitionToken{Base: database.Base{ID: "t1"}, PartitionID: "p1"},
+			wantResource: "partitions:fake",
+			wantResObj:   partition.GetAuthorizationResource(),
+		},
+		{
+			name:           "forbidden",
+			token:          &models.PartitionToken{Base: database.Base{ID: "t1"}, PartitionID: "p1"},
+			wantResource:   "partitions:fake",
+			wantResObj:     partition.GetAuthorizationResource(),
+			authorizedErr:  errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "not found and authorized",
+			wantResource:   "partitions:t1",
+			wantResObj:     missingResObj,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "not found and forbidden must not disclose existence",
+			wantResource:   "partitions:t1",
+			wantResObj:     missingResObj,
+			authorizedErr:  errors.NewForbiddenError("forbidden"),
+			wantStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			daoMock := daosmocks.NewMockDao(ctrl)
+			daoMock.EXPECT().FindTokenByID("t1").Return(tt.token, nil)
+			if tt.token != nil {
+				daoMock.EXPECT().FindByID("p1", gomock.Any()).Return(partition, nil)
+			}
+			if tt.wantStatusCode == 0 {
+				daoMock.EXPECT().SaveToken(gomock.Any()).DoAndReturn(func(t *models.PartitionToken) (*models.PartitionToken, error) {
+					return t, nil
+				})
+			}
+
+			authoSvcMock := amocks.NewMockService(ctrl)
+			authoSvcMock.EXPECT().
+				CheckAuthorizedOnResource(gomock.Any(), "partitions:RevokeToken", tt.wantResource, tt.wantResObj).
+				Return(tt.authorizedErr)
+
+			s := &service{dao: daoMock, authorizationSvc: authoSvcMock, validator: validator.New()}
+
+			ctx := log.SetLoggerToContext(context.TODO(), log.NewLogger())
+
+			got, err := s.RevokeToken(ctx, &models.RevokeTokenInput{ID: "t1"})
+			if tt.wantStatusCode != 0 {
+				assertStatusCode(t, tt.wantStatusCode, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, got.RevokedAt)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package business
 
 import (
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authorization"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses"
@@ -15,6 +16,7 @@ type Services struct {
 	DecisionLogsSvc decisionlogs.Service
 	PartitionsSvc   partitions.Service
 	StatusSvc       statuses.Service
+	DeadLettersSvc  deadletters.Service
 }
 
 func (s *Services) MigrateDB() error {
@@ -22,6 +24,7 @@ func (s *Services) MigrateDB() error {
 		s.DecisionLogsSvc.MigrateDB,
 		s.PartitionsSvc.MigrateDB,
 		s.StatusSvc.MigrateDB,
+		s.DeadLettersSvc.MigrateDB,
 	}
 
 	// Loop over all migrations
@@ -55,16 +58,19 @@ func NewServices(systemLogger log.Logger, db database.DB, authSvc authorization.
 		return nil, err
 	}
 	// Create decision logs service
-	dlSvc := decisionlogs.NewService(db, authSvc, pSvc)
+	dlSvc := decisionlogs.NewService(db, authSvc, pSvc, cfgManager)
 	// Create status service
 	stSvc := statuses.NewService(db, authSvc, pSvc)
+	// Create dead letters service
+	dlqSvc := deadletters.NewService(db, authSvc, pSvc, dlSvc, stSvc)
 	// Add services to partitions service
-	pSvc.AddServices(dlSvc, stSvc)
+	pSvc.AddServices(dlSvc, stSvc, dlqSvc)
 
 	return &Services{
 		systemLogger:    systemLogger,
 		DecisionLogsSvc: dlSvc,
 		PartitionsSvc:   pSvc,
 		StatusSvc:       stSvc,
+		DeadLettersSvc:  dlqSvc,
 	}, nil
 }
@@ -0,0 +1,36 @@
+package statuses
+
+import (
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/models"
+)
+
+// getAuthorizationResource will return resource sent to OPA for a status.
+// Only type and id are known when status doesn't exist.
+func getAuthorizationResource(id string, st *models.Status, partition *pmodels.Partition) *authxmodels.Resource {
+	// Create resource
+	res := &authxmodels.Resource{Type: mainAuthorizationPrefix, ID: id}
+	// Check if status exists
+	if st == nil {
+		return res
+	}
+
+	// Store attributes
+	res.Attributes = map[string]interface{}{
+		"partition": pmodels.NewAuthorizationResource(st.PartitionID, partition),
+	}
+
+	return res
+}
+
+// addAuthorizationProjection will add fields needed for authorization in projection.
+func addAuthorizationProjection(projection *models.Projection) {
+	// Check if a projection is used
+	if projection == nil {
+		return
+	}
+
+	projection.ID = true
+	projection.PartitionID = true
+}
@@ -0,0 +1,72 @@
+//+build unit
+
+package statuses
+
+import (
+	"testing"
+
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getAuthorizationResource(t *testing.T) {
+	partition := &pmodels.Partition{
+		Base:   database.Base{ID: "p1"},
+		Name:   "fake",
+		Labels: pmodels.Labels{"team": "a"},
+	}
+	st := &models.Status{PartitionID: "p1"}
+	st.ID = "s1"
+
+	tests := []struct {
+		name      string
+		status    *models.Status
+		partition *pmodels.Partition
+		want      *authxmodels.Resource
+	}{
+		{
+			name: "status not found",
+			want: &authxmodels.Resource{Type: "statuses", ID: "s1"},
+		},
+		{
+			name:      "status with partition",
+			status:    st,
+			partition: partition,
+			want: &authxmodels.Resource{
+				Type: "statuses",
+				ID:   "s1",
+				Attributes: map[string]interface{}{
+					"partition": &authxmodels.Resource{
+						Type: "partitions",
+						ID:   "p1",
+						Name: "fake",
+						Attributes: map[string]interface{}{
+							"labels": pmodels.Labels{"team": "a"},
+							"owners": pmodels.Owners{},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "status without partition",
+			status: st,
+			want: &authxmodels.Resource{
+				Type: "statuses",
+				ID:   "s1",
+				Attributes: map[string]interface{}{
+					"partition": &authxmodels.Resource{Type: "partitions", ID: "p1"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getAuthorizationResource("s1", tt.status, tt.partition)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -14,12 +14,14 @@ import (
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
 )
 
+//go:generate mockgen -destination=./mocks/mock_Service.go -package=mocks github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses Service
 type Service interface {
 	// Migrate database
 	MigrateDB(systemLogger log.Logger) error
 	// Create decision log used internally only
 	UnsecureCreate(partitionName string, inp map[string]interface{}) error
 	// Get data paginated
+	// Query is a filter query like "createdAt>2021-01-01T00:00:00Z" added to filter
 	GetAllPaginated(
 		ctx context.Context,
 		partitionID string,
@@ -27,15 +29,31 @@ type Service interface {
 		sort *models.SortOrder,
 		filter *models.Filter,
 		projection *models.Projection,
+		query *string,
+	) ([]*models.Status, *pagination.PageOutput, error)
+	// Get data paginated across all partitions that caller is authorized to read
+	// Partition id and name are optional filters on partitions
+	GetAllPaginatedInPartitions(
+		ctx context.Context,
+		partitionID, partitionName *string,
+		page *pagination.PageInput,
+		sort *models.SortOrder,
+		filter *models.Filter,
+		projection *models.Projection,
+		query *string,
 	) ([]*models.Status, *pagination.PageOutput, error)
 	// Find by id
 	FindByID(ctx context.Context, id string, projection *models.Projection) (*models.Status, error)
 	// Manage retention data
 	ManageRetention(logger log.Logger, retentionDuration time.Duration, partitionID string) error
+	// Remove permanently all data of a partition in batches
+	PurgePartition(logger log.Logger, partitionID string) error
 }
 
+//go:generate mockgen -destination=./mocks/mock_PartitionService.go -package=mocks github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses PartitionService
 type PartitionService interface {
 	UnsecureFindByID(id string) (*pmodels.Partition, error)
+	FindAuthorizedIDs(ctx context.Context, id, name *string) ([]string, error)
 }
 
 func NewService(db database.DB, authoSvc authorization.Service, partitionSvc PartitionService) Service {
@@ -6,6 +6,8 @@ import (
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
 )
 
+//go:generate mockgen -destination=./mocks/mock_Dao.go -package=mocks github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/daos Dao
+
 // Dao represent a decision logs access object service.
 type Dao interface {
 	// MigrateDB will migrate database
@@ -23,6 +25,8 @@ type Dao interface {
 	) ([]*models.Status, *pagination.PageOutput, error)
 	// Delete permanently with filter
 	Delete(filter *models.Filter) error
+	// Delete permanently at most limit elements of a partition and return the number of deleted elements
+	DeleteBatchByPartitionID(partitionID string, limit int) (int64, error)
 }
 
 func NewDao(db database.DB) Dao {
@@ -37,6 +37,25 @@ func (s *service) Delete(filter *models.Filter) error {
 	return db.Unscoped().Delete(&models.Status{}).Error
 }
 
+func (s *service) DeleteBatchByPartitionID(partitionID string, limit int) (int64, error) {
+	// Get gorm database
+	gdb := s.db.GetGormDB()
+	// Select ids of batch
+	sub := gdb.Unscoped().
+		Model(&daosmodels.Status{}).
+		Select("id").
+		Where("partition_id = ?", partitionID).
+		Limit(limit)
+	// Delete batch
+	res := gdb.Unscoped().Where("id IN (?)", sub).Delete(&daosmodels.Status{})
+	// Check error
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return res.RowsAffected, nil
+}
+
 func (s *service) FindByID(id string, projection *models.Projection) (*models.Status, error) {
 	// Get gorm database
 	gdb := s.db.GetGormDB()
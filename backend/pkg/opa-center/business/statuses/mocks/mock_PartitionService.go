@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses (interfaces: PartitionService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	reflect "reflect"
+)
+
+// MockPartitionService is a mock of PartitionService interface
+type MockPartitionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartitionServiceMockRecorder
+}
+
+// MockPartitionServiceMockRecorder is the mock recorder for MockPartitionService
+type MockPartitionServiceMockRecorder struct {
+	mock *MockPartitionService
+}
+
+// NewMockPartitionService creates a new mock instance
+func NewMockPartitionService(ctrl *gomock.Controller) *MockPartitionService {
+	mock := &MockPartitionService{ctrl: ctrl}
+	mock.recorder = &MockPartitionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPartitionService) EXPECT() *MockPartitionServiceMockRecorder {
+	return m.recorder
+}
+
+// FindAuthorizedIDs mocks base method
+func (m *MockPartitionService) FindAuthorizedIDs(arg0 context.Context, arg1, arg2 *string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAuthorizedIDs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAuthorizedIDs indicates an expected call of FindAuthorizedIDs
+func (mr *MockPartitionServiceMockRecorder) FindAuthorizedIDs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAuthorizedIDs", reflect.TypeOf((*MockPartitionService)(nil).FindAuthorizedIDs), arg0, arg1, arg2)
+}
+
+// UnsecureFindByID mocks base method
+func (m *MockPartitionService) UnsecureFindByID(arg0 string) (*models.Partition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsecureFindByID", arg0)
+	ret0, _ := ret[0].(*models.Partition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsecureFindByID indicates an expected call of UnsecureFindByID
+func (mr *MockPartitionServiceMockRecorder) UnsecureFindByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsecureFindByID", reflect.TypeOf((*MockPartitionService)(nil).UnsecureFindByID), arg0)
+}
@@ -6,12 +6,13 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/pkg/errors"
-
 	"github.com/go-playground/validator/v10"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authorization"
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/daos"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
@@ -19,6 +20,9 @@ import (
 
 const mainAuthorizationPrefix = "statuses"
 
+// Number of statuses removed in one request when a partition is purged.
+const purgeBatchSize = 1000
+
 type service struct {
 	dao              daos.Dao
 	validator        *validator.Validate
@@ -46,19 +50,69 @@ func (s *service) ManageRetention(logger log.Logger, retentionDuration time.Dura
 	})
 }
 
+func (s *service) PurgePartition(logger log.Logger, partitionID string) error {
+	// Total number of deleted elements
+	var total int64
+	// Delete batches until partition is empty
+	for {
+		// Delete batch
+		count, err := s.dao.DeleteBatchByPartitionID(partitionID, purgeBatchSize)
+		// Check error
+		if err != nil {
+			return err
+		}
+		// Update total
+		total += count
+		// Check if this was the last batch
+		if count < purgeBatchSize {
+			break
+		}
+	}
+
+	logger.Infof("%d statuses removed from partition %s", total, partitionID)
+
+	return nil
+}
+
 func (s *service) FindByID(ctx context.Context, id string, projection *models.Projection) (*models.Status, error) {
+	// Add fields needed for authorization
+	addAuthorizationProjection(projection)
+	// Find status
+	res, err := s.dao.FindByID(id, projection)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Partition of status
+	var partition *pmodels.Partition
+	// Check if status exists
+	if res != nil {
+		// Find partition
+		partition, err = s.partitionSvc.UnsecureFindByID(res.PartitionID)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Hide status when its partition is deleted
+		if partition == nil {
+			res = nil
+		}
+	}
+
 	// Check authorization
-	err := s.authorizationSvc.CheckAuthorized(
+	err = s.authorizationSvc.CheckAuthorizedOnResource(
 		ctx,
 		fmt.Sprintf("%s:FindByID", mainAuthorizationPrefix),
 		fmt.Sprintf("%s:%s", mainAuthorizationPrefix, id),
+		getAuthorizationResource(id, res, partition),
 	)
 	// Check error
 	if err != nil {
 		return nil, err
 	}
 
-	return s.dao.FindByID(id, projection)
+	return res, nil
 }
 
 func (s *service) UnsecureCreate(partitionID string, inp map[string]interface{}) error {
@@ -70,7 +124,7 @@ func (s *service) UnsecureCreate(partitionID string, inp map[string]interface{})
 	}
 	// Check if doesn't partition exist
 	if partition == nil {
-		return errors.New("partition doesn't exist")
+		return errors.NewNotFoundError("partition doesn't exist")
 	}
 
 	bb, err := json.Marshal(inp)
@@ -89,7 +143,7 @@ func (s *service) UnsecureCreate(partitionID string, inp map[string]interface{})
 	err = s.validator.Struct(st)
 	// Check error
 	if err != nil {
-		return err
+		return errors.NewInvalidInputErrorWithError(err)
 	}
 
 	// Save status object
@@ -109,25 +163,95 @@ func (s *service) GetAllPaginated(
 	sort *models.SortOrder,
 	filter *models.Filter,
 	projection *models.Projection,
+	query *string,
+) ([]*models.Status, *pagination.PageOutput, error) {
+	// Find partition
+	partition, err := s.partitionSvc.UnsecureFindByID(partitionID)
+	// Check error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Create authorization resource with partition
+	resource := &authxmodels.Resource{
+		Type: mainAuthorizationPrefix,
+		Attributes: map[string]interface{}{
+			"partition": pmodels.NewAuthorizationResource(partitionID, partition),
+		},
+	}
+
+	return s.getAllPaginated(ctx, resource, &common.GenericFilter{Eq: partitionID}, page, sort, filter, projection, query)
+}
+
+func (s *service) GetAllPaginatedInPartitions(
+	ctx context.Context,
+	partitionID, partitionName *string,
+	page *pagination.PageInput,
+	sort *models.SortOrder,
+	filter *models.Filter,
+	projection *models.Projection,
+	query *string,
+) ([]*models.Status, *pagination.PageOutput, error) {
+	// Get authorized partitions
+	ids, err := s.partitionSvc.FindAuthorizedIDs(ctx, partitionID, partitionName)
+	// Check error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.getAllPaginated(
+		ctx,
+		&authxmodels.Resource{Type: mainAuthorizationPrefix},
+		&common.GenericFilter{In: ids},
+		page, sort, filter, projection, query,
+	)
+}
+
+func (s *service) getAllPaginated(
+	ctx context.Context,
+	resource *authxmodels.Resource,
+	partitionFilter *common.GenericFilter,
+	page *pagination.PageInput,
+	sort *models.SortOrder,
+	filter *models.Filter,
+	projection *models.Projection,
+	query *string,
 ) ([]*models.Status, *pagination.PageOutput, error) {
 	// Check authorization
-	err := s.authorizationSvc.CheckAuthorized(
+	err := s.authorizationSvc.CheckAuthorizedOnResource(
 		ctx,
 		fmt.Sprintf("%s:List", mainAuthorizationPrefix),
 		"",
+		resource,
 	)
 	// Check error
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// Create filter if not exists
-	if filter == nil {
-		filter = &models.Filter{}
+	// Create list of filters
+	// Filters are wrapped in order to not allow OR filters to escape query and partition restrictions
+	ands := make([]*models.Filter, 0)
+	// Check if filter is set
+	if filter != nil {
+		ands = append(ands, filter)
+	}
+
+	// Check if query is set
+	if query != nil {
+		// Parse query
+		qFilter := &models.Filter{}
+		err = common.ParseQuery(*query, qFilter, nil)
+		// Check error
+		if err != nil {
+			return nil, nil, err
+		}
+		// Add query filter
+		ands = append(ands, qFilter)
 	}
 
-	// Add partition id to filter
-	filter.PartitionID = &common.GenericFilter{Eq: partitionID}
+	// Create filter with partition filter
+	filter = &models.Filter{AND: ands, PartitionID: partitionFilter}
 
 	return s.dao.GetAllPaginated(page, sort, filter, projection)
 }
@@ -0,0 +1,132 @@
+//+build unit
+
+package statuses
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	amocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authorization/mocks"
+	authxmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/models"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	daosmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/daos/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// filterSQL will return SQL query generated for a filter.
+func filterSQL(t *testing.T, filter *models.Filter) string {
+	sqlDB, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{DryRun: true, Logger: logger.Discard})
+	assert.NoError(t, err)
+
+	db, err = common.ManageFilter(filter, db)
+	assert.NoError(t, err)
+
+	return db.Table("statuses").Find(&[]map[string]interface{}{}).Statement.SQL.String()
+}
+
+func Test_service_GetAllPaginatedInPartitions_orFilterCannotEscape(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	partitionSvcMock := mocks.NewMockPartitionService(ctrl)
+	partitionSvcMock.EXPECT().FindAuthorizedIDs(gomock.Any(), nil, nil).Return([]string{"p1"}, nil)
+
+	authoSvcMock := amocks.NewMockService(ctrl)
+	authoSvcMock.EXPECT().CheckAuthorizedOnResource(gomock.Any(), "statuses:List", "", gomock.Any()).Return(nil)
+
+	var got *models.Filter
+	daoMock := daosmocks.NewMockDao(ctrl)
+	daoMock.EXPECT().
+		GetAllPaginated(nil, nil, gomock.Any(), nil).
+		DoAndReturn(func(_ *pagination.PageInput, _ *models.SortOrder, filter *models.Filter, _ *models.Projection) ([]*models.Status, *pagination.PageOutput, error) {
+			got = filter
+
+			return nil, nil, nil
+		})
+
+	s := &service{dao: daoMock, partitionSvc: partitionSvcMock, authorizationSvc: authoSvcMock}
+
+	// Caller tries to read another partition with an OR filter
+	filter := &models.Filter{OR: []*models.Filter{
+		{PartitionID: &common.GenericFilter{Eq: "p2"}},
+		{PartitionID: &common.GenericFilter{Eq: "p3"}},
+	}}
+	_, _, err := s.GetAllPaginatedInPartitions(context.TODO(), nil, nil, nil, nil, filter, nil, nil)
+	assert.NoError(t, err)
+
+	// Partition restriction must be applied on the whole caller filter
+	assert.Equal(t,
+		`SELECT * FROM "statuses" WHERE partition_id IN ($1) AND (partition_id = $2 OR partition_id = $3)`,
+		filterSQL(t, got),
+	)
+}
+
+func Test_service_FindByID(t *testing.T) {
+	partition := &pmodels.Partition{Name: "fake"}
+	partition.ID = "p1"
+	st := &models.Status{PartitionID: "p1"}
+	st.ID = "s1"
+
+	tests := []struct {
+		name       string
+		status     *models.Status
+		partition  *pmodels.Partition
+		wantResObj *authxmodels.Resource
+		want       *models.Status
+	}{
+		{
+			name:       "found",
+			status:     st,
+			partition:  partition,
+			wantResObj: getAuthorizationResource("s1", st, partition),
+			want:       st,
+		},
+		{
+			name:       "deleted partition",
+			status:     st,
+			wantResObj: &authxmodels.Resource{Type: "statuses", ID: "s1"},
+		},
+		{
+			name:       "not found",
+			wantResObj: &authxmodels.Resource{Type: "statuses", ID: "s1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			daoMock := daosmocks.NewMockDao(ctrl)
+			daoMock.EXPECT().FindByID("s1", nil).Return(tt.status, nil)
+
+			partitionSvcMock := mocks.NewMockPartitionService(ctrl)
+			if tt.status != nil {
+				partitionSvcMock.EXPECT().UnsecureFindByID("p1").Return(tt.partition, nil)
+			}
+
+			authoSvcMock := amocks.NewMockService(ctrl)
+			authoSvcMock.EXPECT().
+				CheckAuthorizedOnResource(gomock.Any(), "statuses:FindByID", "statuses:s1", tt.wantResObj).
+				Return(nil)
+
+			s := &service{dao: daoMock, partitionSvc: partitionSvcMock, authorizationSvc: authoSvcMock}
+
+			got, err := s.FindByID(context.TODO(), "s1", nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
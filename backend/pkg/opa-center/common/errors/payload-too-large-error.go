@@ -0,0 +1,34 @@
+package errors
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+func NewPayloadTooLargeError(msg string) Error {
+	return NewPayloadTooLargeErrorWithExtensions(msg, nil)
+}
+
+func NewPayloadTooLargeErrorWithError(err error) Error {
+	return NewPayloadTooLargeErrorWithExtensionsAndError(err, nil)
+}
+
+func NewPayloadTooLargeErrorWithExtensions(msg string, customExtensions map[string]interface{}) Error {
+	return NewPayloadTooLargeErrorWithExtensionsAndError(errors.New(msg), customExtensions)
+}
+
+func NewPayloadTooLargeErrorWithExtensionsAndError(err error, customExtensions map[string]interface{}) Error {
+	// Check if custom extensions exists
+	if customExtensions == nil {
+		customExtensions = map[string]interface{}{}
+	}
+	// Add code in custom extensions
+	customExtensions["code"] = "PAYLOAD_TOO_LARGE"
+	// Return new error
+	return &GenericError{
+		err:        errors.WithStack(err),
+		ext:        customExtensions,
+		statusCode: http.StatusRequestEntityTooLarge,
+	}
+}
@@ -0,0 +1,255 @@
+// +build unit
+
+package errors
+
+import (
+	gerrors "errors"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestNewPayloadTooLargeError(t *testing.T) {
+	type args struct {
+		msg string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		err        error
+		ext        map[string]interface{}
+		statusCode int
+	}{
+		{
+			name:       "constructor",
+			args:       args{msg: "fake"},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "PAYLOAD_TOO_LARGE"},
+			statusCode: 413,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewPayloadTooLargeError(tt.args.msg)
+			if !reflect.DeepEqual(got.Error(), tt.err.Error()) {
+				t.Errorf("NewPayloadTooLargeError().err = %v, want %v", got.Error(), tt.err.Error())
+			}
+			if !reflect.DeepEqual(got.Extensions(), tt.ext) {
+				t.Errorf("NewPayloadTooLargeError().ext = %v, want %v", got.Extensions(), tt.ext)
+			}
+			if !reflect.DeepEqual(got.StatusCode(), tt.statusCode) {
+				t.Errorf("NewPayloadTooLargeError().statusCode = %v, want %v", got.StatusCode(), tt.statusCode)
+			}
+			if got.StackTrace() == nil {
+				t.Error("NewPayloadTooLargeError().stackTrace must exists")
+			}
+		})
+	}
+}
+
+func TestNewPayloadTooLargeErrorWithError(t *testing.T) {
+	type args struct {
+		err error
+	}
+	tests := []struct {
+		name       string
+		args       args
+		err        error
+		ext        map[string]interface{}
+		statusCode int
+	}{
+		{
+			name:       "constructor",
+			args:       args{err: errors.New("fake")},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "PAYLOAD_TOO_LARGE"},
+			statusCode: 413,
+		},
+		{
+			name:       "constructor with golang error",
+			args:       args{err: gerrors.New("fake")},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "PAYLOAD_TOO_LARGE"},
+			statusCode: 413,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewPayloadTooLargeErrorWithError(tt.args.err)
+			if !reflect.DeepEqual(got.Error(), tt.err.Error()) {
+				t.Errorf("NewPayloadTooLargeErrorWithError().err = %v, want %v", got.Error(), tt.err.Error())
+			}
+			if !reflect.DeepEqual(got.Extensions(), tt.ext) {
+				t.Errorf("NewPayloadTooLargeErrorWithError().ext = %v, want %v", got.Extensions(), tt.ext)
+			}
+			if !reflect.DeepEqual(got.StatusCode(), tt.statusCode) {
+				t.Errorf("NewPayloadTooLargeErrorWithError().statusCode = %v, want %v", got.StatusCode(), tt.statusCode)
+			}
+			if got.StackTrace() == nil {
+				t.Error("NewPayloadTooLargeErrorWithError().stackTrace must exists")
+			}
+		})
+	}
+}
+
+func TestNewPayloadTooLargeErrorWithExtensions(t *testing.T) {
+	type args struct {
+		msg              string
+		customExtensions map[string]interface{}
+	}
+	tests := []struct {
+		name       string
+		args       args
+		err        error
+		ext        map[string]interface{}
+		statusCode int
+	}{
+		{
+			name: "constructor with nil map",
+			args: args{
+				msg: "fake",
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "PAYLOAD_TOO_LARGE"},
+			statusCode: 413,
+		},
+		{
+			name: "constructor with empty map",
+			args: args{
+				msg:              "fake",
+				customExtensions: map[string]interface{}{},
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "PAYLOAD_TOO_LARGE"},
+			statusCode: 413,
+		},
+		{
+			name: "constructor with existing map",
+			args: args{
+				msg: "fake",
+				customExtensions: map[string]interface{}{
+					"fake": 1,
+				},
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "PAYLOAD_TOO_LARGE", "fake": 1},
+			statusCode: 413,
+		},
+		{
+			name: "constructor with override map",
+			args: args{
+				msg: "fake",
+				customExtensions: map[string]interface{}{
+					"code": 1,
+					"test": true,
+				},
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "PAYLOAD_TOO_LARGE", "test": true},
+			statusCode: 413,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewPayloadTooLargeErrorWithExtensions(tt.args.msg, tt.args.customExtensions)
+			if !reflect.DeepEqual(got.Error(), tt.err.Error()) {
+				t.Errorf("NewPayloadTooLargeErrorWithExtensions().err = %v, want %v", got.Error(), tt.err.Error())
+			}
+			if !reflect.DeepEqual(got.Extensions(), tt.ext) {
+				t.Errorf("NewPayloadTooLargeErrorWithExtensions().ext = %v, want %v", got.Extensions(), tt.ext)
+			}
+			if !reflect.DeepEqual(got.StatusCode(), tt.statusCode) {
+				t.Errorf("NewPayloadTooLargeErrorWithExtensions().statusCode = %v, want %v", got.StatusCode(), tt.statusCode)
+			}
+			if got.StackTrace() == nil {
+				t.Error("NewPayloadTooLargeErrorWithExtensions().stackTrace must exists")
+			}
+		})
+	}
+}
+
+func TestNewPayloadTooLargeErrorWithExtensionsAndError(t *testing.T) {
+	type args struct {
+		err              error
+		customExtensions map[string]interface{}
+	}
+	tests := []struct {
+		name       string
+		args       args
+		err        error
+		ext        map[string]interface{}
+		statusCode int
+	}{
+		{
+			name: "constructor with nil map",
+			args: args{
+				err: errors.New("fake"),
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "PAYLOAD_TOO_LARGE"},
+			statusCode: 413,
+		},
+		{
+			name: "constructor with empty map",
+			args: args{
+				err:              errors.New("fake"),
+				customExtensions: map[string]interface{}{},
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "PAYLOAD_TOO_LARGE"},
+			statusCode: 413,
+		},
+		{
+			name: "constructor with existing map",
+			args: args{
+				err: errors.New("fake"),
+				customExtensions: map[string]interface{}{
+					"fake": 1,
+				},
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "PAYLOAD_TOO_LARGE", "fake": 1},
+			statusCode: 413,
+		},
+		{
+			name: "constructor with override map",
+			args: args{
+				err: errors.New("fake"),
+				customExtensions: map[string]interface{}{
+					"code": 1,
+					"test": true,
+				},
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "PAYLOAD_TOO_LARGE", "test": true},
+			statusCode: 413,
+		},
+		{
+			name: "constructor with golang error",
+			args: args{
+				err: gerrors.New("fake"),
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "PAYLOAD_TOO_LARGE"},
+			statusCode: 413,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewPayloadTooLargeErrorWithExtensionsAndError(tt.args.err, tt.args.customExtensions)
+			if !reflect.DeepEqual(got.Error(), tt.err.Error()) {
+				t.Errorf("NewPayloadTooLargeErrorWithExtensionsAndError().err = %v, want %v", got.Error(), tt.err.Error())
+			}
+			if !reflect.DeepEqual(got.Extensions(), tt.ext) {
+				t.Errorf("NewPayloadTooLargeErrorWithExtensionsAndError().ext = %v, want %v", got.Extensions(), tt.ext)
+			}
+			if !reflect.DeepEqual(got.StatusCode(), tt.statusCode) {
+				t.Errorf("NewPayloadTooLargeErrorWithExtensionsAndError().statusCode = %v, want %v", got.StatusCode(), tt.statusCode)
+			}
+			if got.StackTrace() == nil {
+				t.Error("NewPayloadTooLargeErrorWithExtensionsAndError().stackTrace must exists")
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package errors
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+func NewServiceUnavailableError(msg string) Error {
+	return NewServiceUnavailableErrorWithExtensions(msg, nil)
+}
+
+func NewServiceUnavailableErrorWithError(err error) Error {
+	return NewServiceUnavailableErrorWithExtensionsAndError(err, nil)
+}
+
+func NewServiceUnavailableErrorWithExtensions(msg string, customExtensions map[string]interface{}) Error {
+	return NewServiceUnavailableErrorWithExtensionsAndError(errors.New(msg), customExtensions)
+}
+
+func NewServiceUnavailableErrorWithExtensionsAndError(err error, customExtensions map[string]interface{}) Error {
+	// Check if custom extensions exists
+	if customExtensions == nil {
+		customExtensions = map[string]interface{}{}
+	}
+	// Add code in custom extensions
+	customExtensions["code"] = "SERVICE_UNAVAILABLE"
+	// Return new error
+	return &GenericError{
+		err:        errors.WithStack(err),
+		ext:        customExtensions,
+		statusCode: http.StatusServiceUnavailable,
+	}
+}
@@ -0,0 +1,255 @@
+// +build unit
+
+package errors
+
+import (
+	gerrors "errors"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestNewServiceUnavailableError(t *testing.T) {
+	type args struct {
+		msg string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		err        error
+		ext        map[string]interface{}
+		statusCode int
+	}{
+		{
+			name:       "constructor",
+			args:       args{msg: "fake"},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "SERVICE_UNAVAILABLE"},
+			statusCode: 503,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewServiceUnavailableError(tt.args.msg)
+			if !reflect.DeepEqual(got.Error(), tt.err.Error()) {
+				t.Errorf("NewServiceUnavailableError().err = %v, want %v", got.Error(), tt.err.Error())
+			}
+			if !reflect.DeepEqual(got.Extensions(), tt.ext) {
+				t.Errorf("NewServiceUnavailableError().ext = %v, want %v", got.Extensions(), tt.ext)
+			}
+			if !reflect.DeepEqual(got.StatusCode(), tt.statusCode) {
+				t.Errorf("NewServiceUnavailableError().statusCode = %v, want %v", got.StatusCode(), tt.statusCode)
+			}
+			if got.StackTrace() == nil {
+				t.Error("NewServiceUnavailableError().stackTrace must exists")
+			}
+		})
+	}
+}
+
+func TestNewServiceUnavailableErrorWithError(t *testing.T) {
+	type args struct {
+		err error
+	}
+	tests := []struct {
+		name       string
+		args       args
+		err        error
+		ext        map[string]interface{}
+		statusCode int
+	}{
+		{
+			name:       "constructor",
+			args:       args{err: errors.New("fake")},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "SERVICE_UNAVAILABLE"},
+			statusCode: 503,
+		},
+		{
+			name:       "constructor with golang error",
+			args:       args{err: gerrors.New("fake")},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "SERVICE_UNAVAILABLE"},
+			statusCode: 503,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewServiceUnavailableErrorWithError(tt.args.err)
+			if !reflect.DeepEqual(got.Error(), tt.err.Error()) {
+				t.Errorf("NewServiceUnavailableErrorWithError().err = %v, want %v", got.Error(), tt.err.Error())
+			}
+			if !reflect.DeepEqual(got.Extensions(), tt.ext) {
+				t.Errorf("NewServiceUnavailableErrorWithError().ext = %v, want %v", got.Extensions(), tt.ext)
+			}
+			if !reflect.DeepEqual(got.StatusCode(), tt.statusCode) {
+				t.Errorf("NewServiceUnavailableErrorWithError().statusCode = %v, want %v", got.StatusCode(), tt.statusCode)
+			}
+			if got.StackTrace() == nil {
+				t.Error("NewServiceUnavailableErrorWithError().stackTrace must exists")
+			}
+		})
+	}
+}
+
+func TestNewServiceUnavailableErrorWithExtensions(t *testing.T) {
+	type args struct {
+		msg              string
+		customExtensions map[string]interface{}
+	}
+	tests := []struct {
+		name       string
+		args       args
+		err        error
+		ext        map[string]interface{}
+		statusCode int
+	}{
+		{
+			name: "constructor with nil map",
+			args: args{
+				msg: "fake",
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "SERVICE_UNAVAILABLE"},
+			statusCode: 503,
+		},
+		{
+			name: "constructor with empty map",
+			args: args{
+				msg:              "fake",
+				customExtensions: map[string]interface{}{},
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "SERVICE_UNAVAILABLE"},
+			statusCode: 503,
+		},
+		{
+			name: "constructor with existing map",
+			args: args{
+				msg: "fake",
+				customExtensions: map[string]interface{}{
+					"fake": 1,
+				},
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "SERVICE_UNAVAILABLE", "fake": 1},
+			statusCode: 503,
+		},
+		{
+			name: "constructor with override map",
+			args: args{
+				msg: "fake",
+				customExtensions: map[string]interface{}{
+					"code": 1,
+					"test": true,
+				},
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "SERVICE_UNAVAILABLE", "test": true},
+			statusCode: 503,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewServiceUnavailableErrorWithExtensions(tt.args.msg, tt.args.customExtensions)
+			if !reflect.DeepEqual(got.Error(), tt.err.Error()) {
+				t.Errorf("NewServiceUnavailableErrorWithExtensions().err = %v, want %v", got.Error(), tt.err.Error())
+			}
+			if !reflect.DeepEqual(got.Extensions(), tt.ext) {
+				t.Errorf("NewServiceUnavailableErrorWithExtensions().ext = %v, want %v", got.Extensions(), tt.ext)
+			}
+			if !reflect.DeepEqual(got.StatusCode(), tt.statusCode) {
+				t.Errorf("NewServiceUnavailableErrorWithExtensions().statusCode = %v, want %v", got.StatusCode(), tt.statusCode)
+			}
+			if got.StackTrace() == nil {
+				t.Error("NewServiceUnavailableErrorWithExtensions().stackTrace must exists")
+			}
+		})
+	}
+}
+
+func TestNewServiceUnavailableErrorWithExtensionsAndError(t *testing.T) {
+	type args struct {
+		err              error
+		customExtensions map[string]interface{}
+	}
+	tests := []struct {
+		name       string
+		args       args
+		err        error
+		ext        map[string]interface{}
+		statusCode int
+	}{
+		{
+			name: "constructor with nil map",
+			args: args{
+				err: errors.New("fake"),
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "SERVICE_UNAVAILABLE"},
+			statusCode: 503,
+		},
+		{
+			name: "constructor with empty map",
+			args: args{
+				err:              errors.New("fake"),
+				customExtensions: map[string]interface{}{},
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "SERVICE_UNAVAILABLE"},
+			statusCode: 503,
+		},
+		{
+			name: "constructor with existing map",
+			args: args{
+				err: errors.New("fake"),
+				customExtensions: map[string]interface{}{
+					"fake": 1,
+				},
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "SERVICE_UNAVAILABLE", "fake": 1},
+			statusCode: 503,
+		},
+		{
+			name: "constructor with override map",
+			args: args{
+				err: errors.New("fake"),
+				customExtensions: map[string]interface{}{
+					"code": 1,
+					"test": true,
+				},
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "SERVICE_UNAVAILABLE", "test": true},
+			statusCode: 503,
+		},
+		{
+			name: "constructor with golang error",
+			args: args{
+				err: gerrors.New("fake"),
+			},
+			err:        errors.New("fake"),
+			ext:        map[string]interface{}{"code": "SERVICE_UNAVAILABLE"},
+			statusCode: 503,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewServiceUnavailableErrorWithExtensionsAndError(tt.args.err, tt.args.customExtensions)
+			if !reflect.DeepEqual(got.Error(), tt.err.Error()) {
+				t.Errorf("NewServiceUnavailableErrorWithExtensionsAndError().err = %v, want %v", got.Error(), tt.err.Error())
+			}
+			if !reflect.DeepEqual(got.Extensions(), tt.ext) {
+				t.Errorf("NewServiceUnavailableErrorWithExtensionsAndError().ext = %v, want %v", got.Extensions(), tt.ext)
+			}
+			if !reflect.DeepEqual(got.StatusCode(), tt.statusCode) {
+				t.Errorf("NewServiceUnavailableErrorWithExtensionsAndError().statusCode = %v, want %v", got.StatusCode(), tt.statusCode)
+			}
+			if got.StackTrace() == nil {
+				t.Error("NewServiceUnavailableErrorWithExtensionsAndError().stackTrace must exists")
+			}
+		})
+	}
+}
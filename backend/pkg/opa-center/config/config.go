@@ -15,6 +15,36 @@ const DefaultOPAPublisherPort = 8081
 // DefaultInternalPort Default internal port.
 const DefaultInternalPort = 9090
 
+// StrictIngestionMode Ingestion mode where a payload is rejected entirely when one entry is invalid.
+const StrictIngestionMode = "strict"
+
+// PartialIngestionMode Ingestion mode where valid entries are stored and invalid ones are reported.
+const PartialIngestionMode = "partial"
+
+// UnlimitedIngestionLimit Ingestion limit value removing a limit, even the default one for partitions.
+const UnlimitedIngestionLimit = -1
+
+// DefaultAsyncIngestionQueueSize Default asynchronous ingestion queue size.
+const DefaultAsyncIngestionQueueSize = 1000
+
+// DefaultAsyncIngestionWorkers Default asynchronous ingestion workers number.
+const DefaultAsyncIngestionWorkers = 4
+
+// DefaultAsyncIngestionBatchSize Default asynchronous ingestion batch size.
+const DefaultAsyncIngestionBatchSize = 500
+
+// DefaultAsyncIngestionFlushInterval Default asynchronous ingestion flush interval.
+const DefaultAsyncIngestionFlushInterval = "1s"
+
+// DefaultPartitionPurgeGracePeriod Default time during which a deleted partition can be restored before being purged.
+const DefaultPartitionPurgeGracePeriod = "168h"
+
+// DefaultServerTLSMinVersion Default minimum TLS version of servers.
+const DefaultServerTLSMinVersion = "1.2"
+
+// DefaultAgentJWTPartitionClaim Default JWT claim used to map OPA agents to partitions.
+const DefaultAgentJWTPartitionClaim = "partition"
+
 // DefaultOIDCScopes Default OIDC scopes.
 var DefaultOIDCScopes = []string{"openid", "email", "profile"}
 
@@ -76,6 +106,17 @@ type ServerConfig struct {
 	ListenAddr string            `mapstructure:"listenAddr"`
 	Port       int               `mapstructure:"port" validate:"required"`
 	CORS       *ServerCorsConfig `mapstructure:"cors" validate:"omitempty"`
+	TLS        *ServerTLSConfig  `mapstructure:"tls" validate:"omitempty"`
+}
+
+// ServerTLSConfig Server TLS configuration.
+type ServerTLSConfig struct {
+	CertFile          string   `mapstructure:"certFile" validate:"required"`
+	KeyFile           string   `mapstructure:"keyFile" validate:"required"`
+	ClientCAFile      string   `mapstructure:"clientCaFile" validate:"required_with=RequireClientCert"`
+	RequireClientCert bool     `mapstructure:"requireClientCert"`
+	MinVersion        string   `mapstructure:"minVersion" validate:"omitempty,oneof=1.0 1.1 1.2 1.3"`
+	CipherSuites      []string `mapstructure:"cipherSuites"`
 }
 
 // ServerCorsConfig Server CORS configuration.
@@ -114,7 +155,53 @@ type CredentialConfig struct {
 
 // CenterConfig OPA Center configuration.
 type CenterConfig struct {
-	BaseURL                       string `mapstructure:"baseUrl" validate:"required,url"`
-	CronRetentionProcess          string `mapstructure:"cronRetentionProcess" validate:"required"`
-	SkipRetentionProcessAtStartup bool   `mapstructure:"skipRetentionProcessAtStartup"`
+	BaseURL                       string                 `mapstructure:"baseUrl" validate:"required,url"`
+	CronRetentionProcess          string                 `mapstructure:"cronRetentionProcess" validate:"required"`
+	SkipRetentionProcessAtStartup bool                   `mapstructure:"skipRetentionProcessAtStartup"`
+	PartitionPurgeGracePeriod     string                 `mapstructure:"partitionPurgeGracePeriod"`
+	DecisionLogsIngestionMode     string                 `mapstructure:"decisionLogsIngestionMode" validate:"omitempty,oneof=strict partial"`
+	AsyncIngestion                *AsyncIngestionConfig  `mapstructure:"asyncIngestion"`
+	IngestionLimits               *IngestionLimitsConfig `mapstructure:"ingestionLimits"`
+	AgentJWTAuthentication        *AgentJWTAuthConfig    `mapstructure:"agentJwtAuthentication"`
+	AgentCertAuthentication       *AgentCertAuthConfig   `mapstructure:"agentCertificateAuthentication"`
+}
+
+// AgentCertAuthConfig OPA agents client certificate authentication configuration.
+type AgentCertAuthConfig struct {
+	// Partitions map partition names to allowed certificate identities (subject, common name or SAN)
+	Partitions map[string][]string `mapstructure:"partitions"`
+}
+
+// AgentJWTAuthConfig OPA agents JWT authentication configuration.
+type AgentJWTAuthConfig struct {
+	IssuerURL      string   `mapstructure:"issuerUrl" validate:"required_without=JWKSURL,omitempty,url"`
+	JWKSURL        string   `mapstructure:"jwksUrl" validate:"omitempty,url"`
+	Audience       string   `mapstructure:"audience"`
+	PartitionClaim string   `mapstructure:"partitionClaim"`
+	TokenURL       string   `mapstructure:"tokenUrl" validate:"omitempty,url"`
+	Scopes         []string `mapstructure:"scopes"`
+}
+
+// IngestionLimitsConfig Ingestion limits configuration.
+type IngestionLimitsConfig struct {
+	Default    *IngestionLimitConfig            `mapstructure:"default"`
+	Partitions map[string]*IngestionLimitConfig `mapstructure:"partitions" validate:"omitempty,dive"`
+}
+
+// IngestionLimitConfig Ingestion limit configuration.
+// A zero value means no limit, or the default limit for partitions. UnlimitedIngestionLimit removes the default limit.
+type IngestionLimitConfig struct {
+	RequestsPerSecond  float64 `mapstructure:"requestsPerSecond" validate:"eq=-1|gte=0"`
+	DecisionsPerMinute int     `mapstructure:"decisionsPerMinute" validate:"eq=-1|gte=0"`
+	MaxBodySize        int64   `mapstructure:"maxBodySize" validate:"eq=-1|gte=0"`
+}
+
+// AsyncIngestionConfig Asynchronous ingestion configuration.
+type AsyncIngestionConfig struct {
+	Enabled        bool   `mapstructure:"enabled"`
+	QueueSize      int    `mapstructure:"queueSize" validate:"gte=0"`
+	Workers        int    `mapstructure:"workers" validate:"gte=0"`
+	BatchSize      int    `mapstructure:"batchSize" validate:"gte=0"`
+	FlushInterval  string `mapstructure:"flushInterval"`
+	SpoolDirectory string `mapstructure:"spoolDirectory"`
 }
@@ -265,6 +265,22 @@ func (ctx *managercontext) loadConfiguration() error {
 		}
 	})
 
+	// Loop over all TLS files in order to watch file change
+	funk.ForEach(getServerTLSFiles(&out), func(item interface{}) {
+		filePath := item.(string)
+		// Create channel
+		ch := make(chan bool)
+		// Run the watch file
+		ctx.watchInternalFile(filePath, ch, func() {
+			// File change detected
+			ctx.logger.Infof("Reload TLS file detected for path %s", filePath)
+			// Call all hooks in sequence in order to reload certificates in servers
+			funk.ForEach(ctx.onChangeHooks, func(hook func()) { hook() })
+		})
+		// Add channel to list of channels
+		ctx.internalFileWatchChannels = append(ctx.internalFileWatchChannels, ch)
+	})
+
 	err = validateBusinessConfig(&out)
 	if err != nil {
 		return err
@@ -275,6 +291,35 @@ func (ctx *managercontext) loadConfiguration() error {
 	return nil
 }
 
+// getServerTLSConfigs will return TLS configurations of all servers.
+func getServerTLSConfigs(out *Config) []*ServerTLSConfig {
+	res := make([]*ServerTLSConfig, 0)
+
+	for _, svr := range []*ServerConfig{out.Server, out.InternalServer, out.OPAPublisherServer} {
+		// Check if TLS is enabled on server
+		if svr != nil && svr.TLS != nil {
+			res = append(res, svr.TLS)
+		}
+	}
+
+	return res
+}
+
+// getServerTLSFiles will return all certificate, key and CA files used by servers.
+func getServerTLSFiles(out *Config) []string {
+	res := make([]string, 0)
+
+	for _, tlsCfg := range getServerTLSConfigs(out) {
+		res = append(res, tlsCfg.CertFile, tlsCfg.KeyFile)
+		// Check if client CA file exists
+		if tlsCfg.ClientCAFile != "" {
+			res = append(res, tlsCfg.ClientCAFile)
+		}
+	}
+
+	return funk.UniqString(res)
+}
+
 // Load default values based on business rules.
 func loadBusinessDefaultValues(out *Config) error {
 	// Load default oidc configurations
@@ -299,6 +344,45 @@ func loadBusinessDefaultValues(out *Config) error {
 		out.Tracing = &TracingConfig{Enabled: false}
 	}
 
+	// Load default TLS configurations
+	funk.ForEach(getServerTLSConfigs(out), func(item interface{}) {
+		tlsCfg := item.(*ServerTLSConfig)
+		// Add default minimum version
+		if tlsCfg.MinVersion == "" {
+			tlsCfg.MinVersion = DefaultServerTLSMinVersion
+		}
+	})
+
+	// Load default partition purge grace period
+	if out.Center != nil && out.Center.PartitionPurgeGracePeriod == "" {
+		out.Center.PartitionPurgeGracePeriod = DefaultPartitionPurgeGracePeriod
+	}
+
+	// Load default agent JWT authentication configuration
+	if out.Center != nil && out.Center.AgentJWTAuthentication != nil && out.Center.AgentJWTAuthentication.PartitionClaim == "" {
+		out.Center.AgentJWTAuthentication.PartitionClaim = DefaultAgentJWTPartitionClaim
+	}
+
+	// Load default asynchronous ingestion configuration
+	if out.Center != nil && out.Center.AsyncIngestion != nil {
+		// Add default queue size
+		if out.Center.AsyncIngestion.QueueSize == 0 {
+			out.Center.AsyncIngestion.QueueSize = DefaultAsyncIngestionQueueSize
+		}
+		// Add default workers
+		if out.Center.AsyncIngestion.Workers == 0 {
+			out.Center.AsyncIngestion.Workers = DefaultAsyncIngestionWorkers
+		}
+		// Add default batch size
+		if out.Center.AsyncIngestion.BatchSize == 0 {
+			out.Center.AsyncIngestion.BatchSize = DefaultAsyncIngestionBatchSize
+		}
+		// Add default flush interval
+		if out.Center.AsyncIngestion.FlushInterval == "" {
+			out.Center.AsyncIngestion.FlushInterval = DefaultAsyncIngestionFlushInterval
+		}
+	}
+
 	// TODO Load default values based on business rules
 	return nil
 }
@@ -81,6 +81,7 @@ center:
 					BaseURL:                       "http://localhost:8080",
 					CronRetentionProcess:          "@every 30s",
 					SkipRetentionProcessAtStartup: false,
+					PartitionPurgeGracePeriod:     DefaultPartitionPurgeGracePeriod,
 				},
 			},
 		},
@@ -236,6 +237,7 @@ center:
 			BaseURL:                       "http://localhost:8080",
 			CronRetentionProcess:          "@every 30s",
 			SkipRetentionProcessAtStartup: false,
+			PartitionPurgeGracePeriod:     DefaultPartitionPurgeGracePeriod,
 		},
 	}, res)
 
@@ -279,6 +281,7 @@ log:
 				BaseURL:                       "http://localhost:8080",
 				CronRetentionProcess:          "@every 30s",
 				SkipRetentionProcessAtStartup: false,
+				PartitionPurgeGracePeriod:     DefaultPartitionPurgeGracePeriod,
 			},
 		}, res)
 		return
@@ -398,6 +401,7 @@ center:
 			BaseURL:                       "http://localhost:8080",
 			CronRetentionProcess:          "@every 30s",
 			SkipRetentionProcessAtStartup: false,
+			PartitionPurgeGracePeriod:     DefaultPartitionPurgeGracePeriod,
 		},
 	}, res)
 
@@ -452,6 +456,7 @@ center:
 				BaseURL:                       "http://localhost:8080",
 				CronRetentionProcess:          "@every 30s",
 				SkipRetentionProcessAtStartup: false,
+				PartitionPurgeGracePeriod:     DefaultPartitionPurgeGracePeriod,
 			},
 		}, res)
 		return
@@ -548,6 +553,7 @@ center:
 			BaseURL:                       "http://localhost:8080",
 			CronRetentionProcess:          "@every 30s",
 			SkipRetentionProcessAtStartup: false,
+			PartitionPurgeGracePeriod:     DefaultPartitionPurgeGracePeriod,
 		},
 	}, res)
 
@@ -592,6 +598,7 @@ configuration with error
 				BaseURL:                       "http://localhost:8080",
 				CronRetentionProcess:          "@every 30s",
 				SkipRetentionProcessAtStartup: false,
+				PartitionPurgeGracePeriod:     DefaultPartitionPurgeGracePeriod,
 			},
 		}, res)
 	}
@@ -702,6 +709,7 @@ center:
 			BaseURL:                       "http://localhost:8080",
 			CronRetentionProcess:          "@every 30s",
 			SkipRetentionProcessAtStartup: false,
+			PartitionPurgeGracePeriod:     DefaultPartitionPurgeGracePeriod,
 		},
 	}, res)
 
@@ -752,6 +760,7 @@ opaServerAuthorization:
 				BaseURL:                       "http://localhost:8080",
 				CronRetentionProcess:          "@every 30s",
 				SkipRetentionProcessAtStartup: false,
+				PartitionPurgeGracePeriod:     DefaultPartitionPurgeGracePeriod,
 			},
 		}, res)
 		return
@@ -858,6 +867,7 @@ center:
 			BaseURL:                       "http://localhost:8080",
 			CronRetentionProcess:          "@every 30s",
 			SkipRetentionProcessAtStartup: false,
+			PartitionPurgeGracePeriod:     DefaultPartitionPurgeGracePeriod,
 		},
 	}, res)
 }
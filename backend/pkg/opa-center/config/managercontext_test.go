@@ -54,6 +54,113 @@ func Test_loadBusinessDefaultValues(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "async ingestion",
+			args: args{
+				out: &Config{
+					Center: &CenterConfig{AsyncIngestion: &AsyncIngestionConfig{Enabled: true}},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				Center: &CenterConfig{
+					PartitionPurgeGracePeriod: DefaultPartitionPurgeGracePeriod,
+					AsyncIngestion: &AsyncIngestionConfig{
+						Enabled:       true,
+						QueueSize:     DefaultAsyncIngestionQueueSize,
+						Workers:       DefaultAsyncIngestionWorkers,
+						BatchSize:     DefaultAsyncIngestionBatchSize,
+						FlushInterval: DefaultAsyncIngestionFlushInterval,
+					},
+				},
+			},
+		},
+		{
+			name: "async ingestion with values",
+			args: args{
+				out: &Config{
+					Center: &CenterConfig{AsyncIngestion: &AsyncIngestionConfig{
+						QueueSize:     10,
+						Workers:       1,
+						BatchSize:     5,
+						FlushInterval: "5s",
+					}},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				Center: &CenterConfig{
+					PartitionPurgeGracePeriod: DefaultPartitionPurgeGracePeriod,
+					AsyncIngestion: &AsyncIngestionConfig{
+						QueueSize:     10,
+						Workers:       1,
+						BatchSize:     5,
+						FlushInterval: "5s",
+					},
+				},
+			},
+		},
+		{
+			name: "servers tls",
+			args: args{
+				out: &Config{
+					Server:             &ServerConfig{TLS: &ServerTLSConfig{CertFile: "cert", KeyFile: "key"}},
+					InternalServer:     &ServerConfig{},
+					OPAPublisherServer: &ServerConfig{TLS: &ServerTLSConfig{MinVersion: "1.3"}},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing:            &TracingConfig{Enabled: false},
+				Server:             &ServerConfig{TLS: &ServerTLSConfig{CertFile: "cert", KeyFile: "key", MinVersion: DefaultServerTLSMinVersion}},
+				InternalServer:     &ServerConfig{},
+				OPAPublisherServer: &ServerConfig{TLS: &ServerTLSConfig{MinVersion: "1.3"}},
+			},
+		},
+		{
+			name: "agent jwt authentication",
+			args: args{
+				out: &Config{
+					Center: &CenterConfig{AgentJWTAuthentication: &AgentJWTAuthConfig{IssuerURL: "https://fake"}},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				Center: &CenterConfig{
+					PartitionPurgeGracePeriod: DefaultPartitionPurgeGracePeriod,
+					AgentJWTAuthentication: &AgentJWTAuthConfig{
+						IssuerURL:      "https://fake",
+						PartitionClaim: DefaultAgentJWTPartitionClaim,
+					},
+				},
+			},
+		},
+		{
+			name: "agent jwt authentication with partition claim",
+			args: args{
+				out: &Config{
+					Center: &CenterConfig{AgentJWTAuthentication: &AgentJWTAuthConfig{PartitionClaim: "azp"}},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				Center: &CenterConfig{
+					PartitionPurgeGracePeriod: DefaultPartitionPurgeGracePeriod,
+					AgentJWTAuthentication:    &AgentJWTAuthConfig{PartitionClaim: "azp"},
+				},
+			},
+		},
+		{
+			name: "partition purge grace period",
+			args: args{
+				out: &Config{
+					Center: &CenterConfig{PartitionPurgeGracePeriod: "1h"},
+				},
+			},
+			expectedCfg: &Config{
+				Tracing: &TracingConfig{Enabled: false},
+				Center:  &CenterConfig{PartitionPurgeGracePeriod: "1h"},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -66,3 +173,16 @@ func Test_loadBusinessDefaultValues(t *testing.T) {
 		})
 	}
 }
+
+func Test_getServerTLSFiles(t *testing.T) {
+	out := &Config{
+		Server: &ServerConfig{TLS: &ServerTLSConfig{CertFile: "cert", KeyFile: "key"}},
+		OPAPublisherServer: &ServerConfig{TLS: &ServerTLSConfig{
+			CertFile:     "cert",
+			KeyFile:      "key",
+			ClientCAFile: "ca",
+		}},
+	}
+
+	assert.Equal(t, []string{"cert", "key", "ca"}, getServerTLSFiles(out))
+}
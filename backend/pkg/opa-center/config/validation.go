@@ -1,7 +1,31 @@
 package config
 
+import (
+	"errors"
+	"time"
+)
+
 // Validate configuration in a business way.
 func validateBusinessConfig(out *Config) error {
-	// TODO Validate configuration in a business way
+	// Validate partition purge grace period
+	_, err := time.ParseDuration(out.Center.PartitionPurgeGracePeriod)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Validate asynchronous ingestion flush interval
+	if out.Center.AsyncIngestion != nil {
+		d, err := time.ParseDuration(out.Center.AsyncIngestion.FlushInterval)
+		// Check error
+		if err != nil {
+			return err
+		}
+		// Check that duration is positive
+		if d <= 0 {
+			return errors.New("asynchronous ingestion flush interval must be positive")
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,100 @@
+//+build unit
+
+package config
+
+import "testing"
+
+func Test_validateBusinessConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *Config
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			cfg: &Config{Center: &CenterConfig{
+				PartitionPurgeGracePeriod: "168h",
+				AsyncIngestion:            &AsyncIngestionConfig{FlushInterval: "1s"},
+			}},
+		},
+		{
+			name:    "invalid purge grace period",
+			cfg:     &Config{Center: &CenterConfig{PartitionPurgeGracePeriod: "fake"}},
+			wantErr: true,
+		},
+		{
+			name: "invalid flush interval",
+			cfg: &Config{Center: &CenterConfig{
+				PartitionPurgeGracePeriod: "168h",
+				AsyncIngestion:            &AsyncIngestionConfig{FlushInterval: "fake"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "zero flush interval",
+			cfg: &Config{Center: &CenterConfig{
+				PartitionPurgeGracePeriod: "168h",
+				AsyncIngestion:            &AsyncIngestionConfig{FlushInterval: "0s"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "negative flush interval",
+			cfg: &Config{Center: &CenterConfig{
+				PartitionPurgeGracePeriod: "168h",
+				AsyncIngestion:            &AsyncIngestionConfig{FlushInterval: "-1s"},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateBusinessConfig(tt.cfg); (err != nil) != tt.wantErr {
+				t.Errorf("validateBusinessConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_IngestionLimitConfig_validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *IngestionLimitConfig
+		wantErr bool
+	}{
+		{
+			name: "limits",
+			cfg:  &IngestionLimitConfig{RequestsPerSecond: 0.5, DecisionsPerMinute: 10, MaxBodySize: 100},
+		},
+		{
+			name: "unlimited",
+			cfg: &IngestionLimitConfig{
+				RequestsPerSecond:  UnlimitedIngestionLimit,
+				DecisionsPerMinute: UnlimitedIngestionLimit,
+				MaxBodySize:        UnlimitedIngestionLimit,
+			},
+		},
+		{
+			name:    "invalid requests per second",
+			cfg:     &IngestionLimitConfig{RequestsPerSecond: -0.5},
+			wantErr: true,
+		},
+		{
+			name:    "invalid decisions per minute",
+			cfg:     &IngestionLimitConfig{DecisionsPerMinute: -2},
+			wantErr: true,
+		},
+		{
+			name:    "invalid max body size",
+			cfg:     &IngestionLimitConfig{MaxBodySize: -2},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validate.Struct(tt.cfg); (err != nil) != tt.wantErr {
+				t.Errorf("validate.Struct() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -8,11 +8,12 @@ import (
 )
 
 // Base contains common columns for all tables.
+// Deleted at column enables soft delete: deleted rows are ignored by queries unless Unscoped is used.
 type Base struct {
 	ID        string `gorm:"primary_key"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
-	DeletedAt *time.Time `sql:"index"`
+	DeletedAt gorm.DeletedAt
 }
 
 // BeforeCreate will set a UUID rather than numeric ID.
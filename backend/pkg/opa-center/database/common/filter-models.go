@@ -53,6 +53,18 @@ type GenericFilter struct {
 	// Allow to test if a string isn't ending with another string.
 	// NotEndsWith must be a string
 	NotEndsWith interface{}
+	// Allow to test case insensitive equality to a string.
+	// EqInsensitive must be a string
+	EqInsensitive interface{}
+	// Allow to test if a string contains another string case insensitively.
+	// ContainsInsensitive must be a string
+	ContainsInsensitive interface{}
+	// Allow to test if a string matches a regular expression.
+	// Matches must be a string containing a regular expression supported by both RE2 and PostgreSQL
+	Matches interface{}
+	// Allow to test if a string isn't matching a regular expression.
+	// NotMatches must be a string containing a regular expression supported by both RE2 and PostgreSQL
+	NotMatches interface{}
 	// Allow to test if value is in array
 	In interface{}
 	// Allow to test if value isn't in array
@@ -64,6 +76,7 @@ type GenericFilter struct {
 }
 
 // DateFilter is a structure that will handle filters for dates.
+// Values must be RFC3339 dates or relative dates like "now", "now-15m" or "now-7d/d" (rounded to the day).
 // This must be used as a pointer in other structures to be used automatically in filters.
 // Moreover, a tag containing the database field must be declared.
 // Example:
@@ -104,6 +117,63 @@ type DateFilter struct {
 	IsNotNull bool
 }
 
+// JSONFieldFilter is a structure that will handle filters on a value inside a JSON database column.
+// This must be used as a slice of pointers in other structures to be used automatically in filters.
+// Moreover, a tag containing the database field must be declared and a tag containing
+// the root key inside JSON can be declared.
+// Example:
+// type Filter struct {
+//  AND []*Filter
+//  OR []*Filter
+// 	Field1 []*JSONFieldFilter `dbfield:"field_1" jsonfield:"input"`
+// }
+// .
+type JSONFieldFilter struct {
+	// Dot separated list of keys from JSON root field (empty means the root field itself)
+	Path string
+	// Allow to test equality to (value is compared as string, number, boolean or null)
+	Eq *string
+	// Allow to test non equality to
+	NotEq *string
+	// Allow to test if a string contains another string.
+	Contains *string
+	// Allow to test if a string isn't containing another string.
+	NotContains *string
+	// Allow to test if value is in array
+	In []string
+	// Allow to test if value isn't in array
+	NotIn []string
+	// Allow to test greater or equal than (numbers are compared as numbers, other values as strings)
+	Gte *string
+	// Allow to test greater than
+	Gt *string
+	// Allow to test less or equal than
+	Lte *string
+	// Allow to test less than
+	Lt *string
+	// Allow to test if value is null or missing
+	IsNull bool
+	// Allow to test if value is not null nor missing
+	IsNotNull bool
+}
+
+// JSONArrayFilter is a structure that will handle filters on values of a JSON array database column.
+// Example:
+// type Filter struct {
+//  AND []*Filter
+//  OR []*Filter
+// 	Field1 *JSONArrayFilter `dbfield:"field_1"`
+// }
+// .
+type JSONArrayFilter struct {
+	// Allow to test if array contains value
+	Contains *string
+	// Allow to test if array doesn't contain value
+	NotContains *string
+	// Allow to test if array contains at least one of values
+	ContainsAny []string
+}
+
 // GenericFilterBuilder is an interface that must be implemented in order to work automatic filter.
 // This is done like this in order to add more fields in GenericFilter without the need of upgrading
 // all code in other to be compatible.
@@ -270,6 +340,18 @@ func (d *DateFilter) GetGenericFilter() (*GenericFilter, error) {
 }
 
 func parseTime(x string) (*time.Time, error) {
+	// Check if date is a relative one
+	if isRelativeDate(x) {
+		// Parse relative date
+		t, err := parseRelativeDate(x, timeNow())
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		return &t, nil
+	}
+
 	// Parse date
 	t, err := time.Parse(time.RFC3339, x)
 	// Check error
@@ -3,6 +3,7 @@ package common
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
 	"gorm.io/gorm"
@@ -14,6 +15,12 @@ const andFieldName = "AND"
 // OR field.
 const orFieldName = "OR"
 
+// Maximum length of patterns used in insensitive and regex filters.
+const maxPatternLength = 256
+
+// LIKE wildcards escaper.
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 func ManageFilter(filter interface{}, db *gorm.DB) (*gorm.DB, error) {
 	return manageFilter(filter, db, db, false)
 }
@@ -62,6 +69,30 @@ func manageFilter(filter interface{}, db, originalDB *gorm.DB, skipInputNotObjec
 		}
 		// Get field value
 		fVal := indirect.Field(i)
+		// Check if value is a list of JSON filters
+		if jsonFilters, ok := fVal.Interface().([]*JSONFieldFilter); ok {
+			// Manage JSON filters
+			res2, err := manageJSONFilters(tagVal, fType.Tag.Get(jsonFieldTagName), jsonFilters, res)
+			// Check error
+			if err != nil {
+				return nil, err
+			}
+
+			res = res2
+
+			continue
+		}
+		// Check if value is a JSON array filter
+		if arrayFilter, ok := fVal.Interface().(*JSONArrayFilter); ok {
+			// Test if filter is nil
+			if arrayFilter == nil {
+				continue
+			}
+			// Manage JSON array filter
+			res = manageJSONArrayFilter(tagVal, arrayFilter, res)
+
+			continue
+		}
 		// Check if value is a pointer or not
 		if fVal.Kind() != reflect.Ptr {
 			return nil, errors.NewInvalidInputError(
@@ -285,6 +316,50 @@ func manageFilterRequest(dbCol string, v *GenericFilter, db *gorm.DB) (*gorm.DB,
 
 		dbRes = dbRes.Not(fmt.Sprintf("%s LIKE ?", dbCol), fmt.Sprintf("%%%s", s))
 	}
+	// Check equal insensitive case
+	if v.EqInsensitive != nil {
+		// Get pattern value
+		s, err := getPatternValue(v.EqInsensitive)
+		// Check error
+		if err != nil {
+			return nil, errors.NewInvalidInputError("eqInsensitive " + err.Error())
+		}
+
+		dbRes = dbRes.Where(fmt.Sprintf("%s ILIKE ?", dbCol), escapeLikePattern(s))
+	}
+	// Check contains insensitive case
+	if v.ContainsInsensitive != nil {
+		// Get pattern value
+		s, err := getPatternValue(v.ContainsInsensitive)
+		// Check error
+		if err != nil {
+			return nil, errors.NewInvalidInputError("containsInsensitive " + err.Error())
+		}
+
+		dbRes = dbRes.Where(fmt.Sprintf("%s ILIKE ?", dbCol), fmt.Sprintf("%%%s%%", escapeLikePattern(s)))
+	}
+	// Check matches case
+	if v.Matches != nil {
+		// Get regex value
+		s, err := getRegexValue(v.Matches)
+		// Check error
+		if err != nil {
+			return nil, errors.NewInvalidInputError("matches " + err.Error())
+		}
+
+		dbRes = dbRes.Where(fmt.Sprintf("%s ~ ?", dbCol), s)
+	}
+	// Check not matches case
+	if v.NotMatches != nil {
+		// Get regex value
+		s, err := getRegexValue(v.NotMatches)
+		// Check error
+		if err != nil {
+			return nil, errors.NewInvalidInputError("notMatches " + err.Error())
+		}
+
+		dbRes = dbRes.Not(fmt.Sprintf("%s ~ ?", dbCol), s)
+	}
 	// Check in case
 	if v.In != nil {
 		dbRes = dbRes.Where(fmt.Sprintf("%s IN (?)", dbCol), v.In)
@@ -321,3 +396,47 @@ func getStringValue(x interface{}) (string, error) {
 
 	return val.String(), nil
 }
+
+// getPatternValue will return string value and will check that it isn't too long
+// in order to avoid expensive scans.
+func getPatternValue(x interface{}) (string, error) {
+	// Get string value
+	s, err := getStringValue(x)
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	// Check length
+	if len(s) > maxPatternLength {
+		return "", errors.NewInvalidInputError(fmt.Sprintf("value must not be longer than %d characters", maxPatternLength))
+	}
+
+	return s, nil
+}
+
+// getRegexValue will return string value and will check that it is a supported regular expression.
+// Only the subset of syntax shared by RE2 and PostgreSQL is accepted
+// in order to refuse backreferences and lookarounds that can lead to expensive scans.
+func getRegexValue(x interface{}) (string, error) {
+	// Get pattern value
+	s, err := getPatternValue(x)
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	// Validate regular expression
+	err = validateRegex(s)
+	// Check error
+	if err != nil {
+		return "", errors.NewInvalidInputErrorWithError(err)
+	}
+
+	return s, nil
+}
+
+// escapeLikePattern will escape LIKE wildcards in order to use value as is.
+func escapeLikePattern(s string) string {
+	return likePatternEscaper.Replace(s)
+}
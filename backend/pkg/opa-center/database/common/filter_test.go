@@ -4,6 +4,7 @@ package common
 
 import (
 	"database/sql/driver"
+	"strings"
 	"testing"
 	"time"
 
@@ -1833,6 +1834,106 @@ func Test_manageFilterRequest(t *testing.T) {
 			wantErr:     true,
 			errorString: "notEndsWith value must be a string or *string",
 		},
+		// EQ INSENSITIVE
+		{
+			name: "eq insensitive case with string",
+			args: args{
+				v: &GenericFilter{EqInsensitive: "Fa%k_e\\"},
+			},
+			expectedIntermediateQuery: "WHERE field_1 ILIKE $1",
+			expectedArgs:              []driver.Value{"Fa\\%k\\_e\\\\"},
+		},
+		{
+			name: "eq insensitive case with int",
+			args: args{
+				v: &GenericFilter{EqInsensitive: 1},
+			},
+			wantErr:     true,
+			errorString: "eqInsensitive value must be a string or *string",
+		},
+		{
+			name: "eq insensitive case with too long value",
+			args: args{
+				v: &GenericFilter{EqInsensitive: strings.Repeat("a", 257)},
+			},
+			wantErr:     true,
+			errorString: "eqInsensitive value must not be longer than 256 characters",
+		},
+		// CONTAINS INSENSITIVE
+		{
+			name: "contains insensitive case with *string",
+			args: args{
+				v: &GenericFilter{ContainsInsensitive: starString("Fake")},
+			},
+			expectedIntermediateQuery: "WHERE field_1 ILIKE $1",
+			expectedArgs:              []driver.Value{"%Fake%"},
+		},
+		{
+			name: "contains insensitive case with bool",
+			args: args{
+				v: &GenericFilter{ContainsInsensitive: true},
+			},
+			wantErr:     true,
+			errorString: "containsInsensitive value must be a string or *string",
+		},
+		// MATCHES
+		{
+			name: "matches case with string",
+			args: args{
+				v: &GenericFilter{Matches: "^fa+ke$"},
+			},
+			expectedIntermediateQuery: "WHERE field_1 ~ $1",
+			expectedArgs:              []driver.Value{"^fa+ke$"},
+		},
+		{
+			name: "matches case with backreference",
+			args: args{
+				v: &GenericFilter{Matches: "(a)\\1"},
+			},
+			wantErr:     true,
+			errorString: "matches error parsing regexp: invalid escape sequence: `\\1`",
+		},
+		{
+			name: "matches case with escape having another meaning in PostgreSQL",
+			args: args{
+				v: &GenericFilter{Matches: "\\bfake"},
+			},
+			wantErr:     true,
+			errorString: "matches escape sequence \\b isn't supported",
+		},
+		{
+			name: "matches case with flags",
+			args: args{
+				v: &GenericFilter{Matches: "(?i)fake"},
+			},
+			wantErr:     true,
+			errorString: "matches flags and named groups aren't supported",
+		},
+		{
+			name: "matches case with too long value",
+			args: args{
+				v: &GenericFilter{Matches: strings.Repeat("a", 257)},
+			},
+			wantErr:     true,
+			errorString: "matches value must not be longer than 256 characters",
+		},
+		// NOT MATCHES
+		{
+			name: "not matches case with *string",
+			args: args{
+				v: &GenericFilter{NotMatches: starString("^fake")},
+			},
+			expectedIntermediateQuery: "WHERE NOT field_1 ~ $1",
+			expectedArgs:              []driver.Value{"^fake"},
+		},
+		{
+			name: "not matches case with invalid regex",
+			args: args{
+				v: &GenericFilter{NotMatches: "(fake"},
+			},
+			wantErr:     true,
+			errorString: "notMatches error parsing regexp: missing closing ): `(fake`",
+		},
 		// IN
 		{
 			name: "in case with []string",
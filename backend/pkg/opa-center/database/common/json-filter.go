@@ -0,0 +1,322 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"gorm.io/gorm"
+)
+
+// Separator between keys in JSON filter paths.
+const jsonPathSeparator = "."
+
+func manageJSONFilters(dbCol, jsonField string, filters []*JSONFieldFilter, db *gorm.DB) (*gorm.DB, error) {
+	// Create result
+	dbRes := db
+
+	// Loop over filters
+	for _, f := range filters {
+		// Check if filter is nil
+		if f == nil {
+			continue
+		}
+
+		// Manage filter
+		res, err := manageJSONFilterRequest(dbCol, jsonField, f, dbRes)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		dbRes = res
+	}
+
+	return dbRes, nil
+}
+
+func manageJSONFilterRequest(dbCol, jsonField string, v *JSONFieldFilter, db *gorm.DB) (*gorm.DB, error) {
+	// Create result
+	dbRes := db
+
+	// Build keys
+	keys, err := buildJSONKeys(jsonField, v.Path)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Build postgres path
+	pgPath := buildPostgresTextArray(keys)
+
+	// Check Equal case
+	if v.Eq != nil {
+		query, args, err := buildJSONContainmentQuery(dbCol, keys, []string{*v.Eq})
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		dbRes = dbRes.Where(query, args...)
+	}
+	// Check not equal case
+	if v.NotEq != nil {
+		query, args, err := buildJSONContainmentQuery(dbCol, keys, []string{*v.NotEq})
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		dbRes = dbRes.Not(query, args...)
+	}
+	// Check contains case
+	if v.Contains != nil {
+		dbRes = dbRes.Where(fmt.Sprintf("%s #>> ?::text[] LIKE ?", dbCol), pgPath, fmt.Sprintf("%%%s%%", *v.Contains))
+	}
+	// Check not contains case
+	if v.NotContains != nil {
+		dbRes = dbRes.Not(fmt.Sprintf("%s #>> ?::text[] LIKE ?", dbCol), pgPath, fmt.Sprintf("%%%s%%", *v.NotContains))
+	}
+	// Check in case
+	if v.In != nil {
+		query, args, err := buildJSONContainmentQuery(dbCol, keys, v.In)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		dbRes = dbRes.Where(query, args...)
+	}
+	// Check not in case
+	if v.NotIn != nil {
+		query, args, err := buildJSONContainmentQuery(dbCol, keys, v.NotIn)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		dbRes = dbRes.Not(query, args...)
+	}
+	// Check greater and equal than case
+	if v.Gte != nil {
+		query, args := buildJSONComparisonQuery(dbCol, pgPath, ">=", *v.Gte)
+		dbRes = dbRes.Where(query, args...)
+	}
+	// Check greater than case
+	if v.Gt != nil {
+		query, args := buildJSONComparisonQuery(dbCol, pgPath, ">", *v.Gt)
+		dbRes = dbRes.Where(query, args...)
+	}
+	// Check less and equal than case
+	if v.Lte != nil {
+		query, args := buildJSONComparisonQuery(dbCol, pgPath, "<=", *v.Lte)
+		dbRes = dbRes.Where(query, args...)
+	}
+	// Check less than case
+	if v.Lt != nil {
+		query, args := buildJSONComparisonQuery(dbCol, pgPath, "<", *v.Lt)
+		dbRes = dbRes.Where(query, args...)
+	}
+	// Check is null case
+	if v.IsNull {
+		dbRes = dbRes.Where(fmt.Sprintf("COALESCE(jsonb_typeof(%s #> ?::text[]), 'null') = 'null'", dbCol), pgPath)
+	}
+	// Check is not null case
+	if v.IsNotNull {
+		dbRes = dbRes.Where(fmt.Sprintf("COALESCE(jsonb_typeof(%s #> ?::text[]), 'null') <> 'null'", dbCol), pgPath)
+	}
+
+	// Return
+	return dbRes, nil
+}
+
+func manageJSONArrayFilter(dbCol string, v *JSONArrayFilter, db *gorm.DB) *gorm.DB {
+	// Create result
+	dbRes := db
+
+	// Check contains case
+	if v.Contains != nil {
+		query, args := buildJSONArrayContainmentQuery(dbCol, []string{*v.Contains})
+		dbRes = dbRes.Where(query, args...)
+	}
+	// Check not contains case
+	if v.NotContains != nil {
+		query, args := buildJSONArrayContainmentQuery(dbCol, []string{*v.NotContains})
+		dbRes = dbRes.Not(query, args...)
+	}
+	// Check contains any case
+	if v.ContainsAny != nil {
+		query, args := buildJSONArrayContainmentQuery(dbCol, v.ContainsAny)
+		dbRes = dbRes.Where(query, args...)
+	}
+
+	// Return
+	return dbRes
+}
+
+// buildJSONArrayContainmentQuery will build a query testing if array contains one of the string values.
+// Containment operator is used in order to use GIN indexes.
+func buildJSONArrayContainmentQuery(dbCol string, values []string) (string, []interface{}) {
+	// Create queries
+	queries := make([]string, 0, len(values))
+	// Create arguments
+	args := make([]interface{}, 0, len(values))
+
+	// Loop over values
+	for _, v := range values {
+		// Marshal array containing value
+		// Marshalling a string slice can't fail
+		bb, _ := json.Marshal([]string{v})
+
+		queries = append(queries, fmt.Sprintf("%s @> ?::jsonb", dbCol))
+		args = append(args, string(bb))
+	}
+
+	// Check if there isn't any value
+	if len(queries) == 0 {
+		return "FALSE", args
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(queries, " OR ")), args
+}
+
+// buildJSONKeys will build the list of keys from JSON root field and path.
+func buildJSONKeys(jsonField, path string) ([]string, error) {
+	// Create result
+	res := make([]string, 0)
+
+	// Check if root field is set
+	if jsonField != "" {
+		res = append(res, jsonField)
+	}
+
+	// Check if path is set
+	if path != "" {
+		// Loop over keys
+		for _, k := range strings.Split(path, jsonPathSeparator) {
+			// Check key
+			if k == "" {
+				return nil, errors.NewInvalidInputError(fmt.Sprintf("path %s is invalid", path))
+			}
+
+			res = append(res, k)
+		}
+	}
+
+	// Check that at least one key exists
+	if len(res) == 0 {
+		return nil, errors.NewInvalidInputError("path is required")
+	}
+
+	return res, nil
+}
+
+// buildPostgresTextArray will build a postgres text array literal.
+func buildPostgresTextArray(list []string) string {
+	// Create quoted list
+	quoted := make([]string, 0, len(list))
+	// Loop over list
+	for _, it := range list {
+		// Escape backslashes and double quotes
+		it = strings.ReplaceAll(it, `\`, `\\`)
+		it = strings.ReplaceAll(it, `"`, `\"`)
+		// Append
+		quoted = append(quoted, fmt.Sprintf(`"%s"`, it))
+	}
+
+	return fmt.Sprintf("{%s}", strings.Join(quoted, ","))
+}
+
+// buildJSONContainmentQuery will build a query testing if value at keys is one of the values.
+// Containment operator is used in order to use GIN indexes.
+func buildJSONContainmentQuery(dbCol string, keys, values []string) (string, []interface{}, error) {
+	// Create queries
+	queries := make([]string, 0)
+	// Create arguments
+	args := make([]interface{}, 0)
+
+	// Loop over values
+	for _, v := range values {
+		// Get JSON values
+		candidates, err := jsonValueCandidates(v)
+		// Check error
+		if err != nil {
+			return "", nil, err
+		}
+		// Loop over JSON values
+		for _, jv := range candidates {
+			// Create document containing value at keys
+			var doc interface{} = jv
+			// Loop over keys in reverse order
+			for i := len(keys) - 1; i >= 0; i-- {
+				doc = map[string]interface{}{keys[i]: doc}
+			}
+			// Marshal document
+			bb, err := json.Marshal(doc)
+			// Check error
+			if err != nil {
+				return "", nil, err
+			}
+
+			queries = append(queries, fmt.Sprintf("%s @> ?::jsonb", dbCol))
+			args = append(args, string(bb))
+		}
+	}
+
+	// Check if there isn't any value
+	if len(queries) == 0 {
+		return "FALSE", args, nil
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(queries, " OR ")), args, nil
+}
+
+// jsonValueCandidates will return all JSON values that can be represented by a string.
+// String value is always a candidate, number, boolean and null values are candidates too when
+// string can be parsed as such.
+func jsonValueCandidates(v string) ([]json.RawMessage, error) {
+	// Marshal string value
+	bb, err := json.Marshal(v)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Create result with string value
+	res := []json.RawMessage{bb}
+
+	// Check if value is a boolean or null
+	if v == "true" || v == "false" || v == "null" {
+		return append(res, json.RawMessage(v)), nil
+	}
+
+	// Check if value is a number
+	if isJSONNumber(v) {
+		return append(res, json.RawMessage(v)), nil
+	}
+
+	return res, nil
+}
+
+// buildJSONComparisonQuery will build a comparison query.
+// Numbers are compared as numbers and only with numbers, other values are compared as strings.
+func buildJSONComparisonQuery(dbCol, pgPath, operator, value string) (string, []interface{}) {
+	// Check if value is a number
+	if isJSONNumber(value) {
+		return fmt.Sprintf(
+			"CASE WHEN jsonb_typeof(%s #> ?::text[]) = 'number' THEN (%s #>> ?::text[])::numeric END %s ?",
+			dbCol, dbCol, operator,
+		), []interface{}{pgPath, pgPath, value}
+	}
+
+	return fmt.Sprintf("%s #>> ?::text[] %s ?", dbCol, operator), []interface{}{pgPath, value}
+}
+
+// isJSONNumber will check if value is a valid JSON number.
+func isJSONNumber(v string) bool {
+	// Try to parse number
+	_, err := strconv.ParseFloat(v, 64)
+
+	return err == nil && json.Valid([]byte(v))
+}
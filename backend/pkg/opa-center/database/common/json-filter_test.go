@@ -0,0 +1,202 @@
+//+build unit
+
+package common
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func Test_manageJSONFilters(t *testing.T) {
+	starStr := func(s string) *string { return &s }
+
+	type Person struct {
+		Name string
+	}
+	type Filter1 struct {
+		Field1 []*JSONFieldFilter `dbfield:"field_1" jsonfield:"input"`
+	}
+	type Filter2 struct {
+		Field1 []*JSONFieldFilter `dbfield:"field_1"`
+	}
+	type Filter3 struct {
+		OR     []*Filter3
+		Field1 []*JSONFieldFilter `dbfield:"field_1" jsonfield:"input"`
+	}
+	type Filter4 struct {
+		Field1 *JSONArrayFilter `dbfield:"field_1"`
+	}
+	tests := []struct {
+		name                      string
+		filter                    interface{}
+		expectedIntermediateQuery string
+		expectedArgs              []driver.Value
+		wantErr                   bool
+		errorString               string
+	}{
+		{
+			name:                      "nil list",
+			filter:                    &Filter1{},
+			expectedIntermediateQuery: "",
+			expectedArgs:              []driver.Value{},
+		},
+		{
+			name:                      "equal string",
+			filter:                    &Filter1{Field1: []*JSONFieldFilter{{Path: "user", Eq: starStr("bob")}}},
+			expectedIntermediateQuery: "WHERE (field_1 @> $1::jsonb)",
+			expectedArgs:              []driver.Value{`{"input":{"user":"bob"}}`},
+		},
+		{
+			name:                      "equal number",
+			filter:                    &Filter1{Field1: []*JSONFieldFilter{{Path: "a.b", Eq: starStr("3")}}},
+			expectedIntermediateQuery: "WHERE (field_1 @> $1::jsonb OR field_1 @> $2::jsonb)",
+			expectedArgs:              []driver.Value{`{"input":{"a":{"b":"3"}}}`, `{"input":{"a":{"b":3}}}`},
+		},
+		{
+			name:                      "not equal boolean without root field and path",
+			filter:                    &Filter2{Field1: []*JSONFieldFilter{{NotEq: starStr("false")}}},
+			wantErr:                   true,
+			errorString:               "path is required",
+		},
+		{
+			name:                      "not equal boolean without root field",
+			filter:                    &Filter2{Field1: []*JSONFieldFilter{{Path: "result", NotEq: starStr("false")}}},
+			expectedIntermediateQuery: "WHERE NOT ((field_1 @> $1::jsonb OR field_1 @> $2::jsonb))",
+			expectedArgs:              []driver.Value{`{"result":"false"}`, `{"result":false}`},
+		},
+		{
+			name: "in and not in",
+			filter: &Filter1{Field1: []*JSONFieldFilter{
+				{Path: "user", In: []string{"bob", "alice"}},
+				{Path: "user", NotIn: []string{}},
+			}},
+			expectedIntermediateQuery: "WHERE ((field_1 @> $1::jsonb OR field_1 @> $2::jsonb)) AND NOT FALSE",
+			expectedArgs:              []driver.Value{`{"input":{"user":"bob"}}`, `{"input":{"user":"alice"}}`},
+		},
+		{
+			name: "contains",
+			filter: &Filter1{Field1: []*JSONFieldFilter{
+				{Path: `us"er`, Contains: starStr("bo"), NotContains: starStr("ob")},
+			}},
+			expectedIntermediateQuery: "WHERE field_1 #>> $1::text[] LIKE $2 AND NOT field_1 #>> $3::text[] LIKE $4",
+			expectedArgs:              []driver.Value{`{"input","us\"er"}`, "%bo%", `{"input","us\"er"}`, "%ob%"},
+		},
+		{
+			name: "comparisons",
+			filter: &Filter1{Field1: []*JSONFieldFilter{
+				{Path: "age", Gt: starStr("18"), Lte: starStr("2020-01-01")},
+			}},
+			expectedIntermediateQuery: "WHERE CASE WHEN jsonb_typeof(field_1 #> $1::text[]) = 'number' THEN (field_1 #>> $2::text[])::numeric END > $3 " +
+				"AND field_1 #>> $4::text[] <= $5",
+			expectedArgs: []driver.Value{`{"input","age"}`, `{"input","age"}`, "18", `{"input","age"}`, "2020-01-01"},
+		},
+		{
+			name: "null checks",
+			filter: &Filter1{Field1: []*JSONFieldFilter{
+				{Path: "user", IsNull: true},
+				{Path: "group", IsNotNull: true},
+			}},
+			expectedIntermediateQuery: "WHERE COALESCE(jsonb_typeof(field_1 #> $1::text[]), 'null') = 'null' " +
+				"AND COALESCE(jsonb_typeof(field_1 #> $2::text[]), 'null') <> 'null'",
+			expectedArgs: []driver.Value{`{"input","user"}`, `{"input","group"}`},
+		},
+		{
+			name: "in OR",
+			filter: &Filter3{OR: []*Filter3{
+				{Field1: []*JSONFieldFilter{{Path: "user", Eq: starStr("bob")}}},
+				{Field1: []*JSONFieldFilter{{Path: "user", Eq: starStr("alice")}}},
+			}},
+			expectedIntermediateQuery: "WHERE (field_1 @> $1::jsonb) OR (field_1 @> $2::jsonb)",
+			expectedArgs:              []driver.Value{`{"input":{"user":"bob"}}`, `{"input":{"user":"alice"}}`},
+		},
+		{
+			name:                      "array nil filter",
+			filter:                    &Filter4{},
+			expectedIntermediateQuery: "",
+			expectedArgs:              []driver.Value{},
+		},
+		{
+			name:                      "array contains",
+			filter:                    &Filter4{Field1: &JSONArrayFilter{Contains: starStr("bob")}},
+			expectedIntermediateQuery: "WHERE (field_1 @> $1::jsonb)",
+			expectedArgs:              []driver.Value{`["bob"]`},
+		},
+		{
+			name:                      "array not contains",
+			filter:                    &Filter4{Field1: &JSONArrayFilter{NotContains: starStr("bob")}},
+			expectedIntermediateQuery: "WHERE NOT (field_1 @> $1::jsonb)",
+			expectedArgs:              []driver.Value{`["bob"]`},
+		},
+		{
+			name:                      "array contains any",
+			filter:                    &Filter4{Field1: &JSONArrayFilter{ContainsAny: []string{"bob", "alice"}}},
+			expectedIntermediateQuery: "WHERE (field_1 @> $1::jsonb OR field_1 @> $2::jsonb)",
+			expectedArgs:              []driver.Value{`["bob"]`, `["alice"]`},
+		},
+		{
+			name:                      "array contains any empty",
+			filter:                    &Filter4{Field1: &JSONArrayFilter{ContainsAny: []string{}}},
+			expectedIntermediateQuery: "WHERE FALSE",
+			expectedArgs:              []driver.Value{},
+		},
+		{
+			name:        "invalid path",
+			filter:      &Filter1{Field1: []*JSONFieldFilter{{Path: "a..b", Eq: starStr("bob")}}},
+			wantErr:     true,
+			errorString: "path a..b is invalid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer sqlDB.Close()
+
+			db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{Logger: logger.Discard})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			got, err := manageFilter(tt.filter, db, db, false)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("manageFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errorString {
+				t.Errorf("manageFilter() error = %v, wantErr %v", err, tt.errorString)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			// Create expected query
+			expectedQuery := `SELECT * FROM "people" ` + tt.expectedIntermediateQuery
+			if tt.expectedIntermediateQuery != "" {
+				expectedQuery += " "
+			}
+			expectedQuery += `ORDER BY "people"."name" LIMIT 1`
+
+			mock.ExpectQuery(expectedQuery).
+				WithArgs(tt.expectedArgs...).
+				WillReturnRows(
+					sqlmock.NewRows([]string{"name"}).AddRow("fake"),
+				)
+
+			// Run fake find to force query to be run
+			res := got.First(&Person{})
+			// Test error
+			if res.Error != nil {
+				t.Error(res.Error)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+)
+
+// QueryValueMapper is a function that will transform a query value before putting it in filter.
+// This is used to support aliases like "deny" for enum values.
+type QueryValueMapper func(value string) (string, error)
+
+// Query operators to filter field names.
+var queryOperatorFilterFields = map[string]string{
+	":":  "Eq",
+	"!:": "NotEq",
+	">=": "Gte",
+	">":  "Gt",
+	"<=": "Lte",
+	"<":  "Lt",
+	"~":  "Contains",
+	"!~": "NotContains",
+}
+
+// ParseQuery will parse a query like "path:authz/allow AND timestamp>2021-01-01T00:00:00Z"
+// and will fill the filter object with the corresponding filters.
+// Filter must be a pointer to a filter structure with AND, OR and fields with database tags.
+// Query fields are matched case insensitively with filter field names. JSON filters fields support
+// a dot separated path like "input.user.name".
+// Value mappers are indexed by filter field names.
+// Blank queries are ignored.
+func ParseQuery(query string, filter interface{}, valueMappers map[string]QueryValueMapper) error {
+	// Check if query is blank
+	if strings.TrimSpace(query) == "" {
+		return nil
+	}
+
+	// Get reflect value of filter object
+	rVal := reflect.ValueOf(filter)
+	// Check that filter is a pointer to a structure
+	if rVal.Kind() != reflect.Ptr || rVal.IsNil() || rVal.Elem().Kind() != reflect.Struct {
+		return errors.NewInvalidInputError("filter must be a pointer to an object")
+	}
+
+	// Parse query
+	node, err := parseQuery(query)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Build filter
+	res, err := buildQueryFilter(node, rVal.Elem().Type(), valueMappers)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Save result
+	rVal.Elem().Set(res.Elem())
+
+	return nil
+}
+
+// buildQueryFilter will build a new filter pointer from a query node.
+func buildQueryFilter(node *queryNode, filterType reflect.Type, valueMappers map[string]QueryValueMapper) (reflect.Value, error) {
+	// Create result
+	res := reflect.New(filterType)
+
+	// Check if node is a comparison
+	if node.comparison != nil {
+		// Manage comparison
+		err := manageQueryComparison(node.comparison, res.Elem(), valueMappers)
+		// Check error
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return res, nil
+	}
+
+	// Get list and field name
+	list := node.and
+	fieldName := andFieldName
+	// Check if node is an OR node
+	if node.or != nil {
+		list = node.or
+		fieldName = orFieldName
+	}
+
+	// Get field
+	field := res.Elem().FieldByName(fieldName)
+	// Check that field exists and is a list
+	if !field.IsValid() || field.Kind() != reflect.Slice {
+		return reflect.Value{}, errors.NewInvalidInputError(fmt.Sprintf("filter must have a %s list field", fieldName))
+	}
+
+	// Create list
+	values := reflect.MakeSlice(field.Type(), 0, len(list))
+	// Loop over children
+	for _, child := range list {
+		// Build child
+		v, err := buildQueryFilter(child, filterType, valueMappers)
+		// Check error
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		// Append
+		values = reflect.Append(values, v)
+	}
+
+	// Save list
+	field.Set(values)
+
+	return res, nil
+}
+
+// manageQueryComparison will set the filter corresponding to comparison in filter structure.
+func manageQueryComparison(c *queryComparison, filterVal reflect.Value, valueMappers map[string]QueryValueMapper) error {
+	// Split field name and JSON path
+	name, path := c.field, ""
+	// Check if there is a path
+	if i := strings.Index(c.field, "."); i != -1 {
+		name, path = c.field[:i], c.field[i+1:]
+	}
+
+	// Find filter field
+	fType, ok := findQueryFilterField(filterVal.Type(), name)
+	// Check if field haven't been found
+	if !ok {
+		return newQueryError(c.position, fmt.Sprintf("unknown field %s", name))
+	}
+
+	// Get field value
+	fVal := filterVal.FieldByIndex(fType.Index)
+	// Check if field is a list of JSON filters
+	isJSON := fType.Type == reflect.TypeOf([]*JSONFieldFilter{})
+
+	// Check that path is only used on JSON fields
+	if path != "" && !isJSON {
+		return newQueryError(c.position, fmt.Sprintf("field %s doesn't support paths", name))
+	}
+
+	// Check that field is a pointer to a filter structure
+	if !isJSON && (fType.Type.Kind() != reflect.Ptr || fType.Type.Elem().Kind() != reflect.Struct) {
+		return newQueryError(c.position, fmt.Sprintf("field %s cannot be used in queries", name))
+	}
+
+	// Create filter
+	var subFilter reflect.Value
+	// Check if field is a JSON one
+	if isJSON {
+		subFilter = reflect.ValueOf(&JSONFieldFilter{Path: path})
+	} else {
+		subFilter = reflect.New(fType.Type.Elem())
+	}
+
+	// Check if value is null
+	if !c.quoted && c.value == queryNullValue {
+		// Get null field name
+		var nullFieldName string
+		// Check operator
+		switch c.operator {
+		case ":":
+			nullFieldName = "IsNull"
+		case "!:":
+			nullFieldName = "IsNotNull"
+		default:
+			return newQueryError(c.valuePosition, "null can only be used with : and !: operators")
+		}
+
+		// Get field
+		nullField := subFilter.Elem().FieldByName(nullFieldName)
+		// Check field
+		if !nullField.IsValid() || nullField.Kind() != reflect.Bool {
+			return newQueryError(c.valuePosition, fmt.Sprintf("field %s doesn't support null values", name))
+		}
+		// Set value
+		nullField.SetBool(true)
+	} else {
+		// Get value
+		value := c.value
+		// Check if a value mapper exists
+		if mapper := valueMappers[fType.Name]; mapper != nil {
+			// Map value
+			v, err := mapper(value)
+			// Check error
+			if err != nil {
+				return newQueryError(c.valuePosition, err.Error())
+			}
+
+			value = v
+		}
+
+		// Get operator field
+		opField := subFilter.Elem().FieldByName(queryOperatorFilterFields[c.operator])
+		// Check that operator is supported by filter
+		if !opField.IsValid() {
+			return newQueryError(c.position, fmt.Sprintf("operator %s isn't supported on field %s", c.operator, name))
+		}
+
+		// Set value depending on field kind
+		switch opField.Kind() { //nolint:exhaustive // Other kinds aren't supported
+		case reflect.Interface:
+			opField.Set(reflect.ValueOf(value))
+		case reflect.Ptr:
+			opField.Set(reflect.ValueOf(&value))
+		default:
+			return newQueryError(c.position, fmt.Sprintf("operator %s isn't supported on field %s", c.operator, name))
+		}
+	}
+
+	// Check if field is a JSON one
+	if isJSON {
+		// Append filter
+		fVal.Set(reflect.Append(fVal, subFilter))
+	} else {
+		// Set filter
+		fVal.Set(subFilter)
+	}
+
+	return nil
+}
+
+// findQueryFilterField will find a filter field with a database tag matching name case insensitively.
+func findQueryFilterField(filterType reflect.Type, name string) (reflect.StructField, bool) {
+	// Loop over fields
+	for i := 0; i < filterType.NumField(); i++ {
+		// Get field
+		f := filterType.Field(i)
+		// Get tag on field
+		tagVal := f.Tag.Get(dbColTagName)
+		// Check that field have a tag set and correct
+		if tagVal == "" || tagVal == "-" {
+			// Skip this value
+			continue
+		}
+		// Check name
+		if strings.EqualFold(f.Name, name) {
+			return f, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,126 @@
+//+build unit
+
+package common
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery(t *testing.T) {
+	starStr := func(s string) *string { return &s }
+
+	type Filter struct {
+		AND       []*Filter
+		OR        []*Filter
+		Path      *GenericFilter     `dbfield:"path"`
+		Timestamp *DateFilter        `dbfield:"timestamp"`
+		Outcome   *GenericFilter     `dbfield:"outcome"`
+		Input     []*JSONFieldFilter `dbfield:"original_message" jsonfield:"input"`
+		Ignored   *GenericFilter
+	}
+	mappers := map[string]QueryValueMapper{
+		"Outcome": func(v string) (string, error) {
+			if strings.EqualFold(v, "deny") {
+				return "DENIED", nil
+			}
+
+			return "", fmt.Errorf("unknown outcome %s", v)
+		},
+	}
+	tests := []struct {
+		name        string
+		query       string
+		want        *Filter
+		wantErr     bool
+		errorString string
+	}{
+		{
+			name:  "blank query",
+			query: " ",
+			want:  &Filter{},
+		},
+		{
+			name:  "generic filter",
+			query: "path:authz/allow",
+			want:  &Filter{Path: &GenericFilter{Eq: "authz/allow"}},
+		},
+		{
+			name:  "field name is case insensitive",
+			query: "PATH~authz",
+			want:  &Filter{Path: &GenericFilter{Contains: "authz"}},
+		},
+		{
+			name:  "null value",
+			query: `path:null OR path!:"null"`,
+			want: &Filter{OR: []*Filter{
+				{Path: &GenericFilter{IsNull: true}},
+				{Path: &GenericFilter{NotEq: "null"}},
+			}},
+		},
+		{
+			name:  "date filter and value mapper",
+			query: "outcome:deny AND timestamp>=2021-01-01T00:00:00Z",
+			want: &Filter{AND: []*Filter{
+				{Outcome: &GenericFilter{Eq: "DENIED"}},
+				{Timestamp: &DateFilter{Gte: starStr("2021-01-01T00:00:00Z")}},
+			}},
+		},
+		{
+			name:  "json filters",
+			query: "input.user.name:john input!:null",
+			want: &Filter{AND: []*Filter{
+				{Input: []*JSONFieldFilter{{Path: "user.name", Eq: starStr("john")}}},
+				{Input: []*JSONFieldFilter{{IsNotNull: true}}},
+			}},
+		},
+		{
+			name:        "unknown field",
+			query:       "path:a ignored:b",
+			wantErr:     true,
+			errorString: "invalid query at position 8: unknown field ignored",
+		},
+		{
+			name:        "path on non json field",
+			query:       "path.sub:a",
+			wantErr:     true,
+			errorString: "invalid query at position 1: field path doesn't support paths",
+		},
+		{
+			name:        "unsupported operator",
+			query:       "timestamp~2021",
+			wantErr:     true,
+			errorString: "invalid query at position 1: operator ~ isn't supported on field timestamp",
+		},
+		{
+			name:        "null with unsupported operator",
+			query:       "path>null",
+			wantErr:     true,
+			errorString: "invalid query at position 6: null can only be used with : and !: operators",
+		},
+		{
+			name:        "value mapper error",
+			query:       "outcome:fake",
+			wantErr:     true,
+			errorString: "invalid query at position 9: unknown outcome fake",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &Filter{}
+			err := ParseQuery(tt.query, got, mappers)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				assert.Equal(t, tt.errorString, err.Error())
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
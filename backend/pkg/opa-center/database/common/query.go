@@ -0,0 +1,348 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+)
+
+// Query operators sorted by length in order to match the longest one first.
+var queryOperators = []string{">=", "<=", "!:", "!~", ":", ">", "<", "~"}
+
+// Query keywords.
+const (
+	queryAndKeyword = "AND"
+	queryOrKeyword  = "OR"
+)
+
+// Unquoted value used to test null values.
+const queryNullValue = "null"
+
+// queryNode represents a node of a parsed query.
+// Only one of AND, OR or comparison is set.
+type queryNode struct {
+	and        []*queryNode
+	or         []*queryNode
+	comparison *queryComparison
+}
+
+// queryComparison represents a comparison in a query like "field:value".
+type queryComparison struct {
+	// Field name
+	field string
+	// Operator
+	operator string
+	// Value
+	value string
+	// Is value quoted ?
+	quoted bool
+	// Position of field in query (starting at 1)
+	position int
+	// Position of value in query (starting at 1)
+	valuePosition int
+}
+
+// queryParser is a recursive descent parser for queries.
+// Grammar:
+// query      := or
+// or         := and ("OR" and)*
+// and        := primary (["AND"] primary)*
+// primary    := "(" or ")" | comparison
+// comparison := field operator value
+// value      := quoted string | word.
+type queryParser struct {
+	input []rune
+	pos   int
+}
+
+// newQueryError will create an invalid input error with position.
+func newQueryError(position int, msg string) error {
+	return errors.NewInvalidInputErrorWithExtensions(
+		fmt.Sprintf("invalid query at position %d: %s", position, msg),
+		map[string]interface{}{"position": position},
+	)
+}
+
+// parseQuery will parse a query into a tree.
+func parseQuery(query string) (*queryNode, error) {
+	// Create parser
+	p := &queryParser{input: []rune(query)}
+
+	// Parse
+	res, err := p.parseOr()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that everything have been consumed
+	p.skipSpaces()
+	// Check if end isn't reached
+	if !p.isEnd() {
+		return nil, newQueryError(p.position(), fmt.Sprintf("unexpected %q", string(p.input[p.pos])))
+	}
+
+	return res, nil
+}
+
+func (p *queryParser) parseOr() (*queryNode, error) {
+	// Parse first element
+	first, err := p.parseAnd()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Create list
+	list := []*queryNode{first}
+
+	// Loop while OR keywords are found
+	for p.consumeKeyword(queryOrKeyword) {
+		// Parse next element
+		n, err := p.parseAnd()
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Append
+		list = append(list, n)
+	}
+
+	// Check if there is only one element
+	if len(list) == 1 {
+		return first, nil
+	}
+
+	return &queryNode{or: list}, nil
+}
+
+func (p *queryParser) parseAnd() (*queryNode, error) {
+	// Parse first element
+	first, err := p.parsePrimary()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Create list
+	list := []*queryNode{first}
+
+	for {
+		// Consume optional AND keyword
+		explicit := p.consumeKeyword(queryAndKeyword)
+		// Skip spaces
+		p.skipSpaces()
+		// Check if this is the end of the AND list
+		if !explicit && (p.isEnd() || p.input[p.pos] == ')' || p.isKeyword(queryOrKeyword)) {
+			break
+		}
+
+		// Parse next element
+		n, err := p.parsePrimary()
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Append
+		list = append(list, n)
+	}
+
+	// Check if there is only one element
+	if len(list) == 1 {
+		return first, nil
+	}
+
+	return &queryNode{and: list}, nil
+}
+
+func (p *queryParser) parsePrimary() (*queryNode, error) {
+	// Skip spaces
+	p.skipSpaces()
+
+	// Check if end is reached
+	if p.isEnd() {
+		return nil, newQueryError(p.position(), "unexpected end of query")
+	}
+
+	// Check if this is a group
+	if p.input[p.pos] == '(' {
+		// Save position for errors
+		start := p.position()
+		// Consume parenthesis
+		p.pos++
+
+		// Parse group
+		n, err := p.parseOr()
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		// Skip spaces
+		p.skipSpaces()
+		// Check closing parenthesis
+		if p.isEnd() || p.input[p.pos] != ')' {
+			return nil, newQueryError(start, "missing closing parenthesis")
+		}
+		// Consume parenthesis
+		p.pos++
+
+		return n, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *queryParser) parseComparison() (*queryNode, error) {
+	// Create comparison
+	c := &queryComparison{position: p.position()}
+
+	// Read field
+	start := p.pos
+	// Loop over field characters
+	for !p.isEnd() && isQueryFieldRune(p.input[p.pos]) {
+		p.pos++
+	}
+	// Save field
+	c.field = string(p.input[start:p.pos])
+	// Check field
+	if c.field == "" {
+		return nil, newQueryError(c.position, fmt.Sprintf("field expected, found %q", string(p.input[p.pos])))
+	}
+
+	// Read operator
+	for _, op := range queryOperators {
+		// Check if operator matches
+		if strings.HasPrefix(string(p.input[p.pos:]), op) {
+			c.operator = op
+			p.pos += len([]rune(op))
+
+			break
+		}
+	}
+	// Check operator
+	if c.operator == "" {
+		return nil, newQueryError(p.position(), fmt.Sprintf("operator expected after field %s", c.field))
+	}
+
+	// Save value position
+	c.valuePosition = p.position()
+
+	// Check if end is reached
+	if p.isEnd() || unicode.IsSpace(p.input[p.pos]) || p.input[p.pos] == ')' {
+		return nil, newQueryError(c.valuePosition, fmt.Sprintf("value expected for field %s", c.field))
+	}
+
+	// Check if value is quoted
+	if p.input[p.pos] == '"' {
+		// Read quoted value
+		v, err := p.readQuotedValue()
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		c.value = v
+		c.quoted = true
+
+		return &queryNode{comparison: c}, nil
+	}
+
+	// Read word
+	start = p.pos
+	// Loop until a space or a closing parenthesis
+	for !p.isEnd() && !unicode.IsSpace(p.input[p.pos]) && p.input[p.pos] != ')' {
+		p.pos++
+	}
+	// Save value
+	c.value = string(p.input[start:p.pos])
+
+	return &queryNode{comparison: c}, nil
+}
+
+func (p *queryParser) readQuotedValue() (string, error) {
+	// Save start position for errors
+	start := p.position()
+	// Consume quote
+	p.pos++
+
+	// Create builder
+	var sb strings.Builder
+
+	for !p.isEnd() {
+		// Get character
+		r := p.input[p.pos]
+		p.pos++
+
+		switch r {
+		case '"':
+			return sb.String(), nil
+		case '\\':
+			// Check if end is reached
+			if p.isEnd() {
+				return "", newQueryError(p.position(), "unexpected end of query after escape character")
+			}
+			// Write escaped character
+			sb.WriteRune(p.input[p.pos])
+			p.pos++
+		default:
+			sb.WriteRune(r)
+		}
+	}
+
+	return "", newQueryError(start, "missing closing quote")
+}
+
+// consumeKeyword will consume keyword if it is the next token.
+func (p *queryParser) consumeKeyword(kw string) bool {
+	// Skip spaces
+	p.skipSpaces()
+	// Check keyword
+	if !p.isKeyword(kw) {
+		return false
+	}
+	// Consume
+	p.pos += len(kw)
+
+	return true
+}
+
+// isKeyword will check if keyword is the next token.
+// Keywords are case insensitive and must be followed by a space or a parenthesis.
+func (p *queryParser) isKeyword(kw string) bool {
+	// Get end position
+	end := p.pos + len(kw)
+	// Check length
+	if end > len(p.input) {
+		return false
+	}
+	// Check keyword
+	if !strings.EqualFold(string(p.input[p.pos:end]), kw) {
+		return false
+	}
+
+	// Check next character
+	return end == len(p.input) || unicode.IsSpace(p.input[end]) || p.input[end] == '('
+}
+
+func (p *queryParser) skipSpaces() {
+	for !p.isEnd() && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *queryParser) isEnd() bool {
+	return p.pos >= len(p.input)
+}
+
+// position will return current position starting at 1.
+func (p *queryParser) position() int {
+	return p.pos + 1
+}
+
+// isQueryFieldRune will check if character is allowed in field names.
+func isQueryFieldRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-'
+}
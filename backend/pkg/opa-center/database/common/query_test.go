@@ -0,0 +1,130 @@
+//+build unit
+
+package common
+
+import (
+	"testing"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseQuery(t *testing.T) {
+	cmp := func(field, op, value string, quoted bool, pos, valuePos int) *queryNode {
+		return &queryNode{comparison: &queryComparison{
+			field: field, operator: op, value: value, quoted: quoted, position: pos, valuePosition: valuePos,
+		}}
+	}
+	tests := []struct {
+		name        string
+		query       string
+		want        *queryNode
+		wantErr     bool
+		errorString string
+		position    int
+	}{
+		{
+			name:  "simple comparison",
+			query: "path:authz/allow",
+			want:  cmp("path", ":", "authz/allow", false, 1, 6),
+		},
+		{
+			name:  "all operators",
+			query: "a>=1 b<=2 c!:3 d!~4 e>5 f<6 g~7",
+			want: &queryNode{and: []*queryNode{
+				cmp("a", ">=", "1", false, 1, 4),
+				cmp("b", "<=", "2", false, 6, 9),
+				cmp("c", "!:", "3", false, 11, 14),
+				cmp("d", "!~", "4", false, 16, 19),
+				cmp("e", ">", "5", false, 21, 23),
+				cmp("f", "<", "6", false, 25, 27),
+				cmp("g", "~", "7", false, 29, 31),
+			}},
+		},
+		{
+			name:  "quoted value with escapes",
+			query: `path:"a \"b\" c"`,
+			want:  cmp("path", ":", `a "b" c`, true, 1, 6),
+		},
+		{
+			name:  "AND has priority over OR",
+			query: "a:1 AND b:2 or c:3",
+			want: &queryNode{or: []*queryNode{
+				{and: []*queryNode{cmp("a", ":", "1", false, 1, 3), cmp("b", ":", "2", false, 9, 11)}},
+				cmp("c", ":", "3", false, 16, 18),
+			}},
+		},
+		{
+			name:  "parenthesis",
+			query: "a:1 and (b:2 OR c:3)",
+			want: &queryNode{and: []*queryNode{
+				cmp("a", ":", "1", false, 1, 3),
+				{or: []*queryNode{cmp("b", ":", "2", false, 10, 12), cmp("c", ":", "3", false, 17, 19)}},
+			}},
+		},
+		{
+			name:        "empty query",
+			query:       "  ",
+			wantErr:     true,
+			errorString: "invalid query at position 3: unexpected end of query",
+			position:    3,
+		},
+		{
+			name:        "missing operator",
+			query:       "path authz",
+			wantErr:     true,
+			errorString: "invalid query at position 5: operator expected after field path",
+			position:    5,
+		},
+		{
+			name:        "missing value",
+			query:       "a:1 AND path: ",
+			wantErr:     true,
+			errorString: "invalid query at position 14: value expected for field path",
+			position:    14,
+		},
+		{
+			name:        "missing closing parenthesis",
+			query:       "a:1 (b:2 OR c:3",
+			wantErr:     true,
+			errorString: "invalid query at position 5: missing closing parenthesis",
+			position:    5,
+		},
+		{
+			name:        "missing closing quote",
+			query:       `a:"value`,
+			wantErr:     true,
+			errorString: "invalid query at position 3: missing closing quote",
+			position:    3,
+		},
+		{
+			name:        "unexpected closing parenthesis",
+			query:       "a:1)",
+			wantErr:     true,
+			errorString: `invalid query at position 4: unexpected ")"`,
+			position:    4,
+		},
+		{
+			name:        "missing element after AND",
+			query:       "a:1 AND",
+			wantErr:     true,
+			errorString: "invalid query at position 8: unexpected end of query",
+			position:    8,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseQuery(tt.query)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				assert.Equal(t, tt.errorString, err.Error())
+				assert.Equal(t, tt.position, err.(errors.Error).Extensions()["position"])
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"regexp/syntax"
+	"strings"
+)
+
+// Maximum repetition count supported by PostgreSQL regular expressions.
+const maxRegexRepeat = 255
+
+// Escapes having the same meaning in RE2 and PostgreSQL regular expressions.
+const allowedRegexEscapes = "dDsSwWntr"
+
+// Escapes refused in bracket expressions by PostgreSQL.
+const refusedRegexClassEscapes = "DSW"
+
+// validateRegex will check that a regular expression is in the subset
+// having the same meaning in RE2 and in PostgreSQL advanced regular expressions.
+// Backreferences, lookarounds, flags, named groups and escapes other than
+// punctuation, \d, \D, \s, \S, \w, \W, \n, \t and \r are refused.
+func validateRegex(s string) error {
+	// Parse regular expression in order to check syntax
+	re, err := syntax.Parse(s, syntax.Perl)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Check repetitions
+	err = checkRegexRepeats(re)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Flag to know if current character is in a bracket expression
+	inClass := false
+	// Loop over pattern
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c == '\\':
+			// Syntax is already validated so an escaped character is always present
+			i++
+			esc := s[i]
+			// Check if escape is a punctuation
+			if esc < 0x80 && !isASCIIAlphanumeric(esc) {
+				continue
+			}
+			// Check if escape is supported
+			if !strings.ContainsRune(allowedRegexEscapes, rune(esc)) {
+				return fmt.Errorf("escape sequence \\%c isn't supported", esc)
+			}
+			// Check if escape is supported in bracket expression
+			if inClass && strings.ContainsRune(refusedRegexClassEscapes, rune(esc)) {
+				return fmt.Errorf("escape sequence \\%c isn't supported in bracket expressions", esc)
+			}
+		case inClass:
+			// Check if this is a character class like [:alpha:]
+			if c == '[' && i+1 < len(s) && s[i+1] == ':' {
+				// Go to end of class name
+				i += strings.Index(s[i:], ":]") + 1
+
+				continue
+			}
+			// Check if bracket expression is closed
+			if c == ']' {
+				inClass = false
+			}
+		case c == '[':
+			inClass = true
+			// Skip negation
+			if i+1 < len(s) && s[i+1] == '^' {
+				i++
+			}
+			// Skip closing bracket used as first character because it is a literal
+			if i+1 < len(s) && s[i+1] == ']' {
+				i++
+			}
+		case c == '(' && i+1 < len(s) && s[i+1] == '?':
+			// Only non capturing groups are supported
+			if i+2 >= len(s) || s[i+2] != ':' {
+				return fmt.Errorf("flags and named groups aren't supported")
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkRegexRepeats will check that repetition counts are supported by PostgreSQL.
+func checkRegexRepeats(re *syntax.Regexp) error {
+	// Check repeat
+	if re.Op == syntax.OpRepeat && (re.Min > maxRegexRepeat || re.Max > maxRegexRepeat) {
+		return fmt.Errorf("repetition count must not be greater than %d", maxRegexRepeat)
+	}
+
+	// Loop over sub expressions
+	for _, sub := range re.Sub {
+		// Check sub expression
+		err := checkRegexRepeats(sub)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isASCIIAlphanumeric will return true if character is an ASCII letter or digit.
+func isASCIIAlphanumeric(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+// HasRegexFilter will return true if filter or one of its AND and OR filters uses a regular expression.
+func HasRegexFilter(filter interface{}) bool {
+	// Get indirect value
+	rVal := reflect.Indirect(reflect.ValueOf(filter))
+	// Check if value is an object
+	if rVal.Kind() != reflect.Struct {
+		return false
+	}
+
+	// Get type
+	rType := rVal.Type()
+	// Loop over fields
+	for i := 0; i < rVal.NumField(); i++ {
+		// Get field type
+		fType := rType.Field(i)
+		// Get field value
+		fVal := rVal.Field(i)
+
+		// Check if field is an AND or OR list
+		if fType.Name == andFieldName || fType.Name == orFieldName {
+			// Check that type is a slice
+			if fVal.Kind() != reflect.Slice {
+				continue
+			}
+			// Loop over elements
+			for j := 0; j < fVal.Len(); j++ {
+				// Check element
+				if HasRegexFilter(fVal.Index(j).Interface()) {
+					return true
+				}
+			}
+
+			continue
+		}
+
+		// Check that field is a filter
+		if fType.Tag.Get(dbColTagName) == "" || fVal.Kind() != reflect.Ptr || fVal.IsNil() {
+			continue
+		}
+		// Try to cast it as GenericFilterBuilder
+		gfb, ok := fVal.Interface().(GenericFilterBuilder)
+		// Check if cast was a success
+		if !ok {
+			continue
+		}
+		// Get generic filter
+		gf, err := gfb.GetGenericFilter()
+		// Check error
+		if err != nil || gf == nil {
+			continue
+		}
+		// Check regular expressions
+		if gf.Matches != nil || gf.NotMatches != nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,81 @@
+//+build unit
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateRegex(t *testing.T) {
+	tests := []struct {
+		name        string
+		pattern     string
+		errorString string
+	}{
+		{name: "literal", pattern: "fake"},
+		{name: "anchors and quantifiers", pattern: "^fa+k?e*$"},
+		{name: "alternation and non capturing group", pattern: "(?:authz|admin)/(allow|deny)"},
+		{name: "bounded repetition", pattern: "a{2,255}"},
+		{name: "bracket expression", pattern: "[a-z0-9_\\d]+"},
+		{name: "negated bracket expression with closing bracket literal", pattern: "[^]a]"},
+		{name: "posix class", pattern: "[[:alpha:]]+"},
+		{name: "escaped punctuation", pattern: "\\.\\(\\)\\[\\]\\{\\}\\\\"},
+		{name: "shorthand escapes", pattern: "\\d\\D\\s\\S\\w\\W\\n\\t\\r"},
+		{name: "parenthesis in bracket expression", pattern: "[(?]"},
+		{name: "invalid syntax", pattern: "(fake", errorString: "error parsing regexp: missing closing ): `(fake`"},
+		{name: "backreference", pattern: "(a)\\1", errorString: "error parsing regexp: invalid escape sequence: `\\1`"},
+		{name: "lookahead", pattern: "a(?=b)", errorString: "error parsing regexp: invalid or unsupported Perl syntax: `(?=`"},
+		{name: "word boundary", pattern: "\\bfake\\b", errorString: "escape sequence \\b isn't supported"},
+		{name: "text anchor", pattern: "\\Afake\\z", errorString: "escape sequence \\A isn't supported"},
+		{name: "unicode class", pattern: "\\pL", errorString: "escape sequence \\p isn't supported"},
+		{name: "quoted text", pattern: "\\Qa.b\\E", errorString: "escape sequence \\Q isn't supported"},
+		{name: "hexadecimal escape", pattern: "\\x41", errorString: "escape sequence \\x isn't supported"},
+		{name: "negated shorthand in bracket expression", pattern: "[\\D]", errorString: "escape sequence \\D isn't supported in bracket expressions"},
+		{name: "flags", pattern: "(?i)fake", errorString: "flags and named groups aren't supported"},
+		{name: "named group", pattern: "(?P<name>fake)", errorString: "flags and named groups aren't supported"},
+		{name: "repetition too large", pattern: "a{256}", errorString: "repetition count must not be greater than 255"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRegex(tt.pattern)
+			if tt.errorString != "" {
+				assert.EqualError(t, err, tt.errorString)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestHasRegexFilter(t *testing.T) {
+	type Filter struct {
+		Name  *GenericFilter `dbfield:"name"`
+		Other *GenericFilter
+		AND   []*Filter
+		OR    []*Filter
+	}
+
+	tests := []struct {
+		name   string
+		filter interface{}
+		want   bool
+	}{
+		{name: "nil filter", filter: nil},
+		{name: "nil pointer", filter: (*Filter)(nil)},
+		{name: "without regex", filter: &Filter{Name: &GenericFilter{Eq: "fake"}}},
+		{name: "matches", filter: &Filter{Name: &GenericFilter{Matches: "fake"}}, want: true},
+		{name: "not matches", filter: Filter{Name: &GenericFilter{NotMatches: "fake"}}, want: true},
+		{name: "field without tag", filter: &Filter{Other: &GenericFilter{Matches: "fake"}}},
+		{name: "in AND", filter: &Filter{AND: []*Filter{{}, {Name: &GenericFilter{Matches: "fake"}}}}, want: true},
+		{name: "in nested OR", filter: &Filter{AND: []*Filter{{OR: []*Filter{{Name: &GenericFilter{Matches: "fake"}}}}}}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, HasRegexFilter(tt.filter))
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+)
+
+// Relative date prefix.
+const relativeDateNow = "now"
+
+// Number of days in a week.
+const daysInWeek = 7
+
+// timeNow is used to get current time. This is a variable in order to be mocked in tests.
+var timeNow = time.Now
+
+// isRelativeDate will check if value is a relative date expression.
+func isRelativeDate(x string) bool {
+	return strings.HasPrefix(x, relativeDateNow)
+}
+
+// parseRelativeDate will parse a relative date expression like "now", "now-15m", "now-1d+2h"
+// or "now-7d/d" (rounded to the day).
+// Supported units are s (seconds), m (minutes), h (hours), d (days), w (weeks), M (months) and y (years).
+// Rounding is always done to the start of the unit in UTC. Weeks start on monday.
+func parseRelativeDate(x string, now time.Time) (time.Time, error) {
+	// Force utc
+	res := now.UTC()
+	// Remove prefix
+	rest := strings.TrimPrefix(x, relativeDateNow)
+
+	// Split rounding
+	rounding := ""
+	// Check if there is a rounding
+	if i := strings.Index(rest, "/"); i != -1 {
+		rest, rounding = rest[:i], rest[i+1:]
+	}
+
+	// Loop over operations
+	for rest != "" {
+		// Get sign
+		sign := rest[0]
+		// Check sign
+		if sign != '+' && sign != '-' {
+			return time.Time{}, newRelativeDateError(x, "+ or - expected")
+		}
+
+		// Read number
+		i := 1
+		for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+			i++
+		}
+		// Check that number and unit exist
+		if i == 1 || i == len(rest) {
+			return time.Time{}, newRelativeDateError(x, "number and unit expected after sign")
+		}
+
+		// Parse number
+		n, err := strconv.Atoi(rest[1:i])
+		// Check error
+		if err != nil {
+			return time.Time{}, newRelativeDateError(x, err.Error())
+		}
+		// Apply sign
+		if sign == '-' {
+			n = -n
+		}
+
+		// Add duration
+		res, err = addRelativeDateUnit(res, n, rest[i])
+		// Check error
+		if err != nil {
+			return time.Time{}, newRelativeDateError(x, err.Error())
+		}
+
+		// Continue with rest
+		rest = rest[i+1:]
+	}
+
+	// Check if there isn't any rounding
+	if rounding == "" {
+		return res, nil
+	}
+
+	// Check rounding length
+	if len(rounding) != 1 {
+		return time.Time{}, newRelativeDateError(x, fmt.Sprintf("invalid rounding unit %s", rounding))
+	}
+
+	// Round
+	res, err := roundRelativeDate(res, rounding[0])
+	// Check error
+	if err != nil {
+		return time.Time{}, newRelativeDateError(x, err.Error())
+	}
+
+	return res, nil
+}
+
+func addRelativeDateUnit(t time.Time, n int, unit byte) (time.Time, error) {
+	switch unit {
+	case 's':
+		return t.Add(time.Duration(n) * time.Second), nil
+	case 'm':
+		return t.Add(time.Duration(n) * time.Minute), nil
+	case 'h':
+		return t.Add(time.Duration(n) * time.Hour), nil
+	case 'd':
+		return t.AddDate(0, 0, n), nil
+	case 'w':
+		return t.AddDate(0, 0, daysInWeek*n), nil
+	case 'M':
+		return t.AddDate(0, n, 0), nil
+	case 'y':
+		return t.AddDate(n, 0, 0), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid unit %c", unit)
+	}
+}
+
+func roundRelativeDate(t time.Time, unit byte) (time.Time, error) {
+	switch unit {
+	case 's':
+		return t.Truncate(time.Second), nil
+	case 'm':
+		return t.Truncate(time.Minute), nil
+	case 'h':
+		return t.Truncate(time.Hour), nil
+	case 'd':
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+	case 'w':
+		// Get number of days since monday
+		days := (int(t.Weekday()) + daysInWeek - 1) % daysInWeek
+
+		return time.Date(t.Year(), t.Month(), t.Day()-days, 0, 0, 0, 0, time.UTC), nil
+	case 'M':
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC), nil
+	case 'y':
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid rounding unit %c", unit)
+	}
+}
+
+// newRelativeDateError will create an invalid input error for a relative date.
+func newRelativeDateError(x, msg string) error {
+	return errors.NewInvalidInputError(fmt.Sprintf("invalid relative date %s: %s", x, msg))
+}
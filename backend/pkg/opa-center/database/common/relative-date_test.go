@@ -0,0 +1,134 @@
+//+build unit
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseRelativeDate(t *testing.T) {
+	// Wednesday
+	now := time.Date(2021, time.March, 17, 15, 42, 30, 500, time.FixedZone("fake", 3600))
+	tests := []struct {
+		name        string
+		value       string
+		want        time.Time
+		wantErr     bool
+		errorString string
+	}{
+		{
+			name:  "now",
+			value: "now",
+			want:  time.Date(2021, time.March, 17, 14, 42, 30, 500, time.UTC),
+		},
+		{
+			name:  "minus minutes",
+			value: "now-15m",
+			want:  time.Date(2021, time.March, 17, 14, 27, 30, 500, time.UTC),
+		},
+		{
+			name:  "multiple operations",
+			value: "now-1d+2h-30s",
+			want:  time.Date(2021, time.March, 16, 16, 42, 0, 500, time.UTC),
+		},
+		{
+			name:  "weeks, months and years",
+			value: "now+1w-1M-1y",
+			want:  time.Date(2020, time.February, 24, 14, 42, 30, 500, time.UTC),
+		},
+		{
+			name:  "round to second",
+			value: "now/s",
+			want:  time.Date(2021, time.March, 17, 14, 42, 30, 0, time.UTC),
+		},
+		{
+			name:  "round to hour",
+			value: "now-1h/h",
+			want:  time.Date(2021, time.March, 17, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "round to day",
+			value: "now-7d/d",
+			want:  time.Date(2021, time.March, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "round to week",
+			value: "now/w",
+			want:  time.Date(2021, time.March, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "round to month",
+			value: "now/M",
+			want:  time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "round to year",
+			value: "now/y",
+			want:  time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "missing sign",
+			value:       "now1h",
+			wantErr:     true,
+			errorString: "invalid relative date now1h: + or - expected",
+		},
+		{
+			name:        "missing unit",
+			value:       "now-1",
+			wantErr:     true,
+			errorString: "invalid relative date now-1: number and unit expected after sign",
+		},
+		{
+			name:        "missing number",
+			value:       "now-h",
+			wantErr:     true,
+			errorString: "invalid relative date now-h: number and unit expected after sign",
+		},
+		{
+			name:        "invalid unit",
+			value:       "now-1x",
+			wantErr:     true,
+			errorString: "invalid relative date now-1x: invalid unit x",
+		},
+		{
+			name:        "invalid rounding",
+			value:       "now/dd",
+			wantErr:     true,
+			errorString: "invalid relative date now/dd: invalid rounding unit dd",
+		},
+		{
+			name:        "invalid rounding unit",
+			value:       "now/x",
+			wantErr:     true,
+			errorString: "invalid relative date now/x: invalid rounding unit x",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseRelativeDate(tt.value, now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseRelativeDate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				assert.Equal(t, tt.errorString, err.Error())
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseTime_relative(t *testing.T) {
+	// Mock now
+	oldTimeNow := timeNow
+	defer func() { timeNow = oldTimeNow }()
+	timeNow = func() time.Time { return time.Date(2021, time.March, 17, 15, 42, 30, 0, time.UTC) }
+
+	got, err := parseTime("now-1h")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2021, time.March, 17, 14, 42, 30, 0, time.UTC), *got)
+}
@@ -11,9 +11,61 @@ import (
 // Supported enum type for testing purpose.
 var supportedEnumType = reflect.TypeOf(new(SortOrderEnum))
 
+// Default sort column.
+const defaultSortColumn = "created_at"
+
+// SortColumn represents a sort on a database column.
+type SortColumn struct {
+	// Database column
+	Column string
+	// Is descending order ?
+	Desc bool
+}
+
+// String will return the order clause of sort column.
+func (s *SortColumn) String() string {
+	// Check if order is descending
+	if s.Desc {
+		return fmt.Sprintf("%s %s", s.Column, SortOrderEnumDesc.String())
+	}
+
+	return fmt.Sprintf("%s %s", s.Column, SortOrderEnumAsc.String())
+}
+
+// DefaultSortColumns will return the default sort applied when no sort is given.
+func DefaultSortColumns() []*SortColumn {
+	return []*SortColumn{{Column: defaultSortColumn, Desc: true}}
+}
+
 func ManageSortOrder(sort interface{}, db *gorm.DB) (*gorm.DB, error) {
+	// Get sort columns
+	cols, err := GetSortColumns(sort)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if one sort was applied or not in order to put the default one
+	if len(cols) == 0 {
+		cols = DefaultSortColumns()
+	}
+
 	// Create result
 	res := db
+	// Loop over columns
+	for _, col := range cols {
+		// Apply order
+		res = res.Order(col.String())
+	}
+
+	return res, nil
+}
+
+// GetSortColumns will return the list of sort columns declared in sort object.
+// Result is empty if sort object is nil or doesn't contain any sort.
+func GetSortColumns(sort interface{}) ([]*SortColumn, error) {
+	// Create result
+	res := make([]*SortColumn, 0)
 	// Get reflect value of sort object
 	rVal := reflect.ValueOf(sort)
 	// Get kind of sort
@@ -21,7 +73,7 @@ func ManageSortOrder(sort interface{}, db *gorm.DB) (*gorm.DB, error) {
 	// Check nil
 	if rKind == reflect.Invalid || (rKind == reflect.Ptr && rVal.IsNil()) {
 		// Stop here
-		return manageDefaultSort(res), nil
+		return res, nil
 	}
 	// Check if kind is supported
 	if rKind != reflect.Struct && rKind != reflect.Ptr {
@@ -34,8 +86,6 @@ func ManageSortOrder(sort interface{}, db *gorm.DB) (*gorm.DB, error) {
 	indData := indirect.Interface()
 	// Get type of indirect value
 	typeOfIndi := reflect.TypeOf(indData)
-	// Variable to know at the end if one sort was applied
-	sortApplied := false
 
 	// Loop over all num fields
 	for i := 0; i < indirect.NumField(); i++ {
@@ -63,20 +113,9 @@ func ManageSortOrder(sort interface{}, db *gorm.DB) (*gorm.DB, error) {
 		val := fVal.Interface()
 		// Cast value to Sort Order Enum
 		enu := val.(*SortOrderEnum)
-		// Apply order
-		res = res.Order(fmt.Sprintf("%s %s", tagVal, enu.String()))
-		// Store sort applied
-		sortApplied = true
-	}
-
-	// Check if one sort was applied or not in order to put the default one
-	if !sortApplied {
-		res = manageDefaultSort(res)
+		// Append column
+		res = append(res, &SortColumn{Column: tagVal, Desc: *enu == SortOrderEnumDesc})
 	}
 
 	return res, nil
 }
-
-func manageDefaultSort(db *gorm.DB) *gorm.DB {
-	return db.Order("created_at DESC")
-}
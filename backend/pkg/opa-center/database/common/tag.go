@@ -1,3 +1,5 @@
 package common
 
 const dbColTagName = "dbfield"
+
+const jsonFieldTagName = "jsonfield"
@@ -0,0 +1,247 @@
+package pagination
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Id column used to have a stable order.
+const idColumn = "id"
+
+// Cursor represents the position of an element in a sorted list.
+type Cursor struct {
+	// Values of sort columns of element indexed by column name
+	Values map[string]json.RawMessage `json:"v"`
+}
+
+// applyCursor will add conditions in order to get elements after cursor in sort order
+// (or before cursor in backward pagination).
+// NULL values are placed like PostgreSQL does: last in ascending order and first in descending order.
+func applyCursor(db *gorm.DB, result interface{}, cols []*common.SortColumn, cursor *Cursor, backward bool) (*gorm.DB, error) {
+	// Get schema of result elements
+	sch, err := parseSchema(db, result)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get cursor values
+	values := make([]interface{}, 0, len(cols))
+	// Get nullable columns
+	nullable := make([]bool, 0, len(cols))
+	// Loop over columns
+	for _, col := range cols {
+		// Get raw value
+		raw, ok := cursor.Values[col.Column]
+		// Check if value exists
+		if !ok {
+			return nil, errors.NewInvalidInputError("cursor doesn't match sort")
+		}
+		// Get field
+		field := sch.LookUpField(col.Column)
+		// Check if field exists
+		if field == nil {
+			return nil, errors.NewInvalidInputError(fmt.Sprintf("sort column %s doesn't exist", col.Column))
+		}
+		// Create value with field type
+		v := reflect.New(field.FieldType)
+		// Parse value
+		err = json.Unmarshal(raw, v.Interface())
+		// Check error
+		if err != nil {
+			return nil, errors.NewInvalidInputError("cursor doesn't match sort")
+		}
+		// Column can be null only if field is a pointer
+		nullable = append(nullable, field.FieldType.Kind() == reflect.Ptr)
+		// Get value
+		value := v.Elem()
+		// Check if value is a nil pointer
+		if value.Kind() == reflect.Ptr && value.IsNil() {
+			values = append(values, nil)
+		} else {
+			values = append(values, value.Interface())
+		}
+	}
+
+	// Build condition
+	query, args := buildSeekCondition(cols, values, nullable, backward)
+
+	return db.Where(query, args...), nil
+}
+
+// buildSeekCondition will build condition to get elements strictly after values in sort order.
+// A row comparison is used when all columns have the same order and can't be null
+// in order to use indexes. Example for "a DESC, id DESC": (a, id) < (?, ?).
+// Otherwise, the expanded form is used. Example for "a DESC, id ASC": (a < ?) OR (a = ? AND id > ?).
+func buildSeekCondition(cols []*common.SortColumn, values []interface{}, nullable []bool, backward bool) (string, []interface{}) {
+	// Check if row comparison can be used
+	if canUseRowComparison(cols, nullable) {
+		// Get operator
+		op := ">"
+		// Check order
+		if cols[0].Desc != backward {
+			op = "<"
+		}
+
+		// Get column names and placeholders
+		names := make([]string, 0, len(cols))
+		placeholders := make([]string, 0, len(cols))
+		// Loop over columns
+		for _, col := range cols {
+			names = append(names, col.Column)
+			placeholders = append(placeholders, "?")
+		}
+
+		return fmt.Sprintf("(%s) %s (%s)", strings.Join(names, ", "), op, strings.Join(placeholders, ", ")), values
+	}
+
+	// Create result
+	terms := make([]string, 0, len(cols))
+	args := make([]interface{}, 0)
+
+	// Loop over columns
+	for i, col := range cols {
+		// Get order
+		desc := col.Desc != backward
+		// Get value
+		value := values[i]
+
+		// Build after condition on column
+		var after string
+
+		switch {
+		case desc && value == nil:
+			// Null values are first, so all not null values are after
+			after = fmt.Sprintf("%s IS NOT NULL", col.Column)
+		case desc:
+			after = fmt.Sprintf("%s < ?", col.Column)
+		case value == nil:
+			// Null values are last, so nothing is after
+			continue
+		case nullable[i]:
+			// Null values are last, so they are after
+			after = fmt.Sprintf("(%s > ? OR %s IS NULL)", col.Column, col.Column)
+		default:
+			after = fmt.Sprintf("%s > ?", col.Column)
+		}
+
+		// Create parts with equality on previous columns
+		parts := make([]string, 0, i+1)
+		// Arguments of term
+		termArgs := make([]interface{}, 0, i+1)
+		// Loop over previous columns
+		for j := 0; j < i; j++ {
+			// Check if value is null
+			if values[j] == nil {
+				parts = append(parts, fmt.Sprintf("%s IS NULL", cols[j].Column))
+			} else {
+				parts = append(parts, fmt.Sprintf("%s = ?", cols[j].Column))
+				termArgs = append(termArgs, values[j])
+			}
+		}
+		// Add after condition
+		parts = append(parts, after)
+		// Check if value must be added
+		if value != nil {
+			termArgs = append(termArgs, value)
+		}
+
+		// Save term
+		terms = append(terms, fmt.Sprintf("(%s)", strings.Join(parts, " AND ")))
+		args = append(args, termArgs...)
+	}
+
+	// Check if there isn't any term
+	if len(terms) == 0 {
+		return "FALSE", args
+	}
+
+	// Check if there is only one term
+	if len(terms) == 1 {
+		return terms[0], args
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(terms, " OR ")), args
+}
+
+// canUseRowComparison will check that all columns have the same order and can't be null.
+func canUseRowComparison(cols []*common.SortColumn, nullable []bool) bool {
+	// Loop over columns
+	for i, col := range cols {
+		// Check column
+		if nullable[i] || col.Desc != cols[0].Desc {
+			return false
+		}
+	}
+
+	return true
+}
+
+// buildCursors will build cursors of all elements in result.
+func buildCursors(db *gorm.DB, result interface{}, cols []*common.SortColumn) ([]*Cursor, error) {
+	// Get schema of result elements
+	sch, err := parseSchema(db, result)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get list value
+	listVal := reflect.Indirect(reflect.ValueOf(result))
+	// Create result
+	res := make([]*Cursor, 0, listVal.Len())
+
+	// Loop over elements
+	for i := 0; i < listVal.Len(); i++ {
+		// Get element
+		el := reflect.Indirect(listVal.Index(i))
+		// Create cursor
+		c := &Cursor{Values: map[string]json.RawMessage{}}
+
+		// Loop over columns
+		for _, col := range cols {
+			// Get field
+			field := sch.LookUpField(col.Column)
+			// Check if field exists
+			if field == nil {
+				return nil, errors.NewInvalidInputError(fmt.Sprintf("sort column %s doesn't exist", col.Column))
+			}
+			// Get value
+			v, _ := field.ValueOf(el)
+			// Marshal value
+			raw, err := json.Marshal(v)
+			// Check error
+			if err != nil {
+				return nil, err
+			}
+			// Save
+			c.Values[col.Column] = raw
+		}
+
+		// Append
+		res = append(res, c)
+	}
+
+	return res, nil
+}
+
+// parseSchema will parse gorm schema of result elements.
+func parseSchema(db *gorm.DB, result interface{}) (*schema.Schema, error) {
+	// Create statement
+	stmt := &gorm.Statement{DB: db}
+	// Parse
+	err := stmt.Parse(result)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return stmt.Schema, nil
+}
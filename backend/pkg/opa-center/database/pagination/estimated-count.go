@@ -0,0 +1,48 @@
+package pagination
+
+import (
+	"encoding/json"
+
+	"gorm.io/gorm"
+)
+
+// queryPlan represents a query plan returned by an EXPLAIN in JSON format.
+type queryPlan struct {
+	Plan struct {
+		// Number of rows estimated by the planner
+		PlanRows float64 `json:"Plan Rows"`
+	} `json:"Plan"`
+}
+
+// estimateCount will ask the PostgreSQL planner how many rows the query would return.
+// This is really faster than a count on large tables but it relies on table statistics,
+// so the result is only an estimation.
+func estimateCount(db *gorm.DB, result interface{}) (int64, error) {
+	// Raw plan
+	var raw string
+	// Explain query in a new session in order to avoid mixing raw query with current conditions
+	err := db.Session(&gorm.Session{NewDB: true}).
+		Raw("EXPLAIN (FORMAT JSON) ?", db.Model(result)).
+		Row().
+		Scan(&raw)
+	// Check error
+	if err != nil {
+		return 0, err
+	}
+
+	// Parse plans
+	var plans []*queryPlan
+	// Unmarshal
+	err = json.Unmarshal([]byte(raw), &plans)
+	// Check error
+	if err != nil {
+		return 0, err
+	}
+
+	// Check if a plan exists
+	if len(plans) == 0 {
+		return 0, nil
+	}
+
+	return int64(plans[0].Plan.PlanRows), nil
+}
@@ -1,23 +1,52 @@
 package pagination
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	cerrors "github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
 	"gorm.io/gorm"
 )
 
+// Default limit.
+const defaultLimit = 10
+
+// Maximum duration of queries with regular expression filters.
+// Regular expressions cannot use indexes and need to scan all filtered rows.
+const regexStatementTimeout = 10 * time.Second
+
+// PostgreSQL error code for canceled queries.
+const queryCanceledSQLState = "57014"
+
 // PageInput represents an input pagination configuration.
 type PageInput struct {
-	Skip  int
+	// Maximum number of elements
 	Limit int
+	// Get elements after this cursor
+	After *Cursor
+	// Get elements before this cursor (used without After)
+	Before *Cursor
+	// Don't compute total number of elements.
+	// Counting all elements can be really slow on large tables.
+	WithoutCount bool
+	// Compute an estimation of total number of elements with the query planner.
+	WithEstimatedCount bool
 }
 
 // PageOutput represents an output pagination structure.
 type PageOutput struct {
-	TotalRecord int
-	Limit       int
-	Skip        int
-	HasPrevious bool
-	HasNext     bool
+	// Total number of elements (only computed when count isn't disabled)
+	TotalRecord int64
+	// Estimated number of elements (only computed when estimated count is enabled)
+	EstimatedRecord int64
+	Limit           int
+	HasPrevious     bool
+	HasNext         bool
+	// Cursors of elements in the same order as result
+	Cursors []*Cursor
 }
 
 // PagingOptions represents pagination options.
@@ -28,6 +57,8 @@ type PagingOptions struct {
 	PageInput *PageInput
 	// Must be a pointer to an object with *SortOrderEnum objects with tags
 	Sort interface{}
+	// Sort used when sort object doesn't contain any sort (created_at DESC if empty)
+	DefaultSort []*common.SortColumn
 	// Must be a pointer to an object with *GenericFilter objects or implementing the GenericFilterBuilder interface and with tags
 	Filter interface{}
 	// Must be a pointer to an object with booleans with tags
@@ -37,6 +68,8 @@ type PagingOptions struct {
 }
 
 // Paging function in order to have a paginated sorted and filters list of objects.
+// Pagination is done with keyset: elements are sorted with sort columns and id and
+// cursors contain those values in order to get elements after or before them.
 // Parameters:
 // - result: Must be a pointer to a list of objects
 // - options: Pagination options
@@ -45,15 +78,53 @@ func Paging(
 	result interface{},
 	options *PagingOptions,
 ) (*PageOutput, error) {
+	// Get page input
+	p := options.PageInput
+	// Check page input
+	if p == nil {
+		p = &PageInput{}
+	}
 	// Manage default limit
-	if options.PageInput.Limit == 0 {
-		options.PageInput.Limit = 10
+	if p.Limit == 0 {
+		p.Limit = defaultLimit
+	}
+	// Check cursors
+	if p.After != nil && p.Before != nil {
+		return nil, cerrors.NewInvalidInputError("after and before cursors can't be used together")
+	}
+
+	// Get sort columns
+	cols, err := common.GetSortColumns(options.Sort)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check if default sort must be applied
+	if len(cols) == 0 {
+		cols = options.DefaultSort
+	}
+	// Check if default sort is empty
+	if len(cols) == 0 {
+		cols = common.DefaultSortColumns()
+	}
+	// Add id in order to have a stable order
+	cols = append(cols, &common.SortColumn{Column: idColumn, Desc: cols[len(cols)-1].Desc})
+
+	// Get cursor
+	cursor := p.After
+	// Check if this is a backward pagination
+	backward := p.Before != nil
+	// Check if this is a backward pagination
+	if backward {
+		cursor = p.Before
 	}
 
 	var count int64 = 0
 
-	// Create transaction to avoid situations where count and find are different
-	err := options.DB.Transaction(func(db *gorm.DB) error {
+	var estimated int64 = 0
+
+	// Create run function
+	run := func(db *gorm.DB) error {
 		// Apply filter
 		db, err := common.ManageFilter(options.Filter, db)
 		// Check error
@@ -70,18 +141,44 @@ func Paging(
 			}
 		}
 
-		// Count all objects
-		db = db.Model(result).Count(&count)
-		// Check error
-		if db.Error != nil {
-			return db.Error
+		// Check if count is enabled
+		if !p.WithoutCount {
+			// Count all objects
+			db = db.Model(result).Count(&count)
+			// Check error
+			if db.Error != nil {
+				return db.Error
+			}
+		}
+
+		// Check if estimated count is enabled
+		if p.WithEstimatedCount {
+			// Estimate number of objects
+			estimated, err = estimateCount(db, result)
+			// Check error
+			if err != nil {
+				return err
+			}
+		}
+
+		// Check if cursor exists
+		if cursor != nil {
+			// Apply cursor
+			db, err = applyCursor(db, result, cols, cursor, backward)
+			// Check error
+			if err != nil {
+				return err
+			}
 		}
 
 		// Apply sort
-		db, err = common.ManageSortOrder(options.Sort, db)
-		// Check error
-		if err != nil {
-			return err
+		for _, col := range cols {
+			// Check if order must be reversed in backward pagination
+			if backward {
+				col = &common.SortColumn{Column: col.Column, Desc: !col.Desc}
+			}
+			// Apply order
+			db = db.Order(col.String())
 		}
 
 		// Apply projection
@@ -90,37 +187,143 @@ func Paging(
 		if err != nil {
 			return err
 		}
+		// Check if a projection is applied
+		if len(db.Statement.Selects) != 0 {
+			// Add sort columns to projection in order to build cursors
+			db = db.Select(addMissingColumns(db.Statement.Selects, cols))
+		}
 
-		// Request to database with limit and offset
-		db = db.Limit(options.PageInput.Limit).Offset(options.PageInput.Skip).Find(result)
+		// Request to database with one more element in order to know if there are more elements
+		db = db.Limit(p.Limit + 1).Find(result)
 		// Check error
 		if db.Error != nil {
 			return db.Error
 		}
 
 		return nil
-	})
+	}
 
+	// Check if regular expressions are used
+	switch {
+	case common.HasRegexFilter(options.Filter):
+		// Create transaction in order to limit duration of queries
+		err = options.DB.Transaction(func(tx *gorm.DB) error {
+			// Set timeout only for this transaction
+			err := tx.Exec(fmt.Sprintf("SET LOCAL statement_timeout = %d", regexStatementTimeout.Milliseconds())).Error
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			return run(tx)
+		})
+		// Check if query has been canceled by timeout
+		if isQueryCanceled(err) {
+			return nil, cerrors.NewInvalidInputError(
+				fmt.Sprintf("query with regular expression filters took more than %s, add more filters", regexStatementTimeout),
+			)
+		}
+	case p.WithoutCount:
+		err = run(options.DB)
+	default:
+		// Create transaction to avoid situations where count and find are different
+		err = options.DB.Transaction(run)
+	}
 	// Check error
 	if err != nil {
 		return nil, err
 	}
 
-	return getPageOutput(options.PageInput, count), nil
+	// Get list value
+	listVal := reflect.Indirect(reflect.ValueOf(result))
+	// Check if there are more elements
+	more := listVal.Len() > p.Limit
+	// Check if list must be truncated
+	if more {
+		listVal.Set(listVal.Slice(0, p.Limit))
+	}
+	// Check if list must be reversed
+	if backward {
+		// Get swapper
+		swap := reflect.Swapper(listVal.Interface())
+		// Reverse
+		for i, j := 0, listVal.Len()-1; i < j; i, j = i+1, j-1 {
+			swap(i, j)
+		}
+	}
+
+	// Build cursors
+	cursors, err := buildCursors(options.DB, result, cols)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return getPageOutput(p, count, estimated, more, cursors), nil
 }
 
-func getPageOutput(p *PageInput, count int64) *PageOutput {
+// isQueryCanceled will return true if error is a PostgreSQL canceled query error.
+func isQueryCanceled(err error) bool {
+	// Try to get SQL state
+	var pgErr interface{ SQLState() string }
+	// Check error
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.SQLState() == queryCanceledSQLState
+}
+
+func getPageOutput(p *PageInput, count, estimated int64, more bool, cursors []*Cursor) *PageOutput {
 	var paginator PageOutput
 	// Create total record
-	paginator.TotalRecord = int(count)
-	// Store skip
-	paginator.Skip = p.Skip
+	paginator.TotalRecord = count
+	// Store estimated record
+	paginator.EstimatedRecord = estimated
 	// Store limit
 	paginator.Limit = p.Limit
-	// Calculate has next page
-	paginator.HasNext = (p.Limit+p.Skip < paginator.TotalRecord)
-	// Calculate has previous page
-	paginator.HasPrevious = (p.Skip != 0)
+	// Store cursors
+	paginator.Cursors = cursors
+
+	// Check if this is a backward pagination
+	if p.Before != nil {
+		// There are elements after the cursor
+		paginator.HasNext = true
+		// Calculate has previous page
+		paginator.HasPrevious = more
+	} else {
+		// Calculate has next page
+		paginator.HasNext = more
+		// There are elements before the cursor
+		paginator.HasPrevious = p.After != nil
+	}
 
 	return &paginator
 }
+
+// addMissingColumns will add columns that aren't present in selected columns.
+func addMissingColumns(selects []string, cols []*common.SortColumn) []string {
+	// Create result
+	res := append([]string{}, selects...)
+
+	// Loop over columns
+	for _, col := range cols {
+		// Check if column is already selected
+		found := false
+		// Loop over selected columns
+		for _, s := range selects {
+			// Check if this is the column
+			if s == col.Column {
+				found = true
+
+				break
+			}
+		}
+		// Check if column must be added
+		if !found {
+			res = append(res, col.Column)
+		}
+	}
+
+	return res
+}
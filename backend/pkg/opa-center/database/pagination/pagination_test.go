@@ -4,9 +4,10 @@ package pagination
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
-	"reflect"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/common"
@@ -17,7 +18,11 @@ import (
 )
 
 func TestPaging(t *testing.T) {
-	type Person struct{ Name string }
+	type Person struct {
+		ID        string
+		CreatedAt time.Time
+		Name      *string
+	}
 	type Sort struct {
 		Name *common.SortOrderEnum `dbfield:"name"`
 	}
@@ -34,126 +39,194 @@ func TestPaging(t *testing.T) {
 		projection interface{}
 		extraFunc  func(db *gorm.DB) (*gorm.DB, error)
 	}
+	date1 := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	date2 := time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC)
+	rawDate1, _ := json.Marshal(date1)
+	rawDate2, _ := json.Marshal(date2)
+	rows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "created_at", "name"}).
+			AddRow("2", date2, "fake2").
+			AddRow("1", date1, nil)
+	}
+	defaultCursor := func(id string, raw []byte) *Cursor {
+		return &Cursor{Values: map[string]json.RawMessage{
+			"created_at": raw,
+			"id":         json.RawMessage(`"` + id + `"`),
+		}}
+	}
+	nameCursor := func(id, name string) *Cursor {
+		return &Cursor{Values: map[string]json.RawMessage{
+			"name": json.RawMessage(name),
+			"id":   json.RawMessage(`"` + id + `"`),
+		}}
+	}
 	tests := []struct {
 		name                            string
 		args                            args
+		withCount                       bool
 		countExpectedIntermediateQuery  string
 		countExpectedArgs               []driver.Value
+		countResult                     int
+		estimatedExpectedQuery          string
+		estimatedExpectedArgs           []driver.Value
+		estimatedPlan                   string
 		selectExpectedIntermediateQuery string
 		selectExpectedArgs              []driver.Value
 		selectExpectedProjectionQuery   string
-		countResult                     int
 		want                            *PageOutput
 		wantErr                         bool
+		errorString                     string
 	}{
 		{
-			name: "no sort, no filter, no extra function, no limit",
+			name: "no sort, no filter, no extra function, no limit with count",
 			args: args{
 				p: &PageInput{},
 			},
+			withCount:                       true,
 			countExpectedIntermediateQuery:  "",
 			countExpectedArgs:               []driver.Value{},
-			countResult:                     3,
-			selectExpectedIntermediateQuery: "ORDER BY created_at DESC LIMIT 10",
+			countResult:                     2,
+			selectExpectedIntermediateQuery: "ORDER BY created_at DESC,id DESC LIMIT 11",
 			selectExpectedArgs:              []driver.Value{},
 			selectExpectedProjectionQuery:   "*",
-			want:                            &PageOutput{TotalRecord: 3, Limit: 10},
+			want: &PageOutput{
+				TotalRecord: 2,
+				Limit:       10,
+				Cursors:     []*Cursor{defaultCursor("2", rawDate2), defaultCursor("1", rawDate1)},
+			},
 		},
 		{
-			name: "no sort, no filter, no extra function",
+			name: "no sort, no filter, no extra function without count with next page",
 			args: args{
-				p: &PageInput{Limit: 5},
+				p: &PageInput{Limit: 1, WithoutCount: true},
 			},
-			countExpectedIntermediateQuery:  "",
-			countExpectedArgs:               []driver.Value{},
-			countResult:                     3,
-			selectExpectedIntermediateQuery: "ORDER BY created_at DESC LIMIT 5",
+			selectExpectedIntermediateQuery: "ORDER BY created_at DESC,id DESC LIMIT 2",
 			selectExpectedArgs:              []driver.Value{},
 			selectExpectedProjectionQuery:   "*",
-			want:                            &PageOutput{TotalRecord: 3, Limit: 5},
+			want: &PageOutput{
+				Limit:   1,
+				HasNext: true,
+				Cursors: []*Cursor{defaultCursor("2", rawDate2)},
+			},
 		},
 		{
-			name: "no sort, no filter, no extra function with next page",
+			name: "filter with estimated count",
 			args: args{
-				p: &PageInput{Limit: 5},
+				p:      &PageInput{Limit: 5, WithoutCount: true, WithEstimatedCount: true},
+				filter: &Filter{Name: &common.GenericFilter{NotEq: "fake"}},
 			},
-			countExpectedIntermediateQuery:  "",
-			countExpectedArgs:               []driver.Value{},
-			countResult:                     30,
-			selectExpectedIntermediateQuery: "ORDER BY created_at DESC LIMIT 5",
-			selectExpectedArgs:              []driver.Value{},
+			estimatedExpectedQuery:          `EXPLAIN (FORMAT JSON) SELECT * FROM "people" WHERE NOT name = $1`,
+			estimatedExpectedArgs:           []driver.Value{"fake"},
+			estimatedPlan:                   `[{"Plan": {"Node Type": "Seq Scan", "Plan Rows": 1234}}]`,
+			selectExpectedIntermediateQuery: "WHERE NOT name = $1 ORDER BY created_at DESC,id DESC LIMIT 6",
+			selectExpectedArgs:              []driver.Value{"fake"},
 			selectExpectedProjectionQuery:   "*",
-			want:                            &PageOutput{TotalRecord: 30, Limit: 5, HasNext: true},
+			want: &PageOutput{
+				EstimatedRecord: 1234,
+				Limit:           5,
+				Cursors:         []*Cursor{defaultCursor("2", rawDate2), defaultCursor("1", rawDate1)},
+			},
 		},
 		{
-			name: "no sort, no filter, no extra function with next and previous page and skip",
+			name: "sort, filter and after cursor",
 			args: args{
-				p: &PageInput{Limit: 5, Skip: 20},
+				p:      &PageInput{Limit: 5, After: nameCursor("3", `"fake3"`), WithoutCount: true},
+				sort:   &Sort{Name: &common.SortOrderEnumAsc},
+				filter: &Filter{Name: &common.GenericFilter{NotEq: "fake"}},
 			},
-			countExpectedIntermediateQuery:  "",
-			countExpectedArgs:               []driver.Value{},
-			countResult:                     30,
-			selectExpectedIntermediateQuery: "ORDER BY created_at DESC LIMIT 5 OFFSET 20",
-			selectExpectedArgs:              []driver.Value{},
+			selectExpectedIntermediateQuery: "WHERE NOT name = $1 AND ((((name > $2 OR name IS NULL)) OR (name = $3 AND id > $4))) " +
+				"ORDER BY name ASC,id ASC LIMIT 6",
+			selectExpectedArgs:            []driver.Value{"fake", "fake3", "fake3", "3"},
+			selectExpectedProjectionQuery: "*",
+			want: &PageOutput{
+				Limit:       5,
+				HasPrevious: true,
+				Cursors:     []*Cursor{nameCursor("2", `"fake2"`), nameCursor("1", "null")},
+			},
+		},
+		{
+			name: "sort and after cursor with null value",
+			args: args{
+				p:    &PageInput{Limit: 5, After: nameCursor("3", "null"), WithoutCount: true},
+				sort: &Sort{Name: &common.SortOrderEnumDesc},
+			},
+			selectExpectedIntermediateQuery: "WHERE ((name IS NOT NULL) OR (name IS NULL AND id < $1)) ORDER BY name DESC,id DESC LIMIT 6",
+			selectExpectedArgs:              []driver.Value{"3"},
 			selectExpectedProjectionQuery:   "*",
-			want:                            &PageOutput{TotalRecord: 30, Limit: 5, Skip: 20, HasNext: true, HasPrevious: true},
+			want: &PageOutput{
+				Limit:       5,
+				HasPrevious: true,
+				Cursors:     []*Cursor{nameCursor("2", `"fake2"`), nameCursor("1", "null")},
+			},
 		},
 		{
-			name: "sort, filter, no extra function with next and previous page and skip",
+			name: "before cursor with default sort",
 			args: args{
-				p:      &PageInput{Limit: 5, Skip: 20},
-				sort:   &Sort{Name: &common.SortOrderEnumDesc},
-				filter: &Filter{Name: &common.GenericFilter{Eq: "fake"}},
+				p: &PageInput{Limit: 1, Before: defaultCursor("0", rawDate1), WithoutCount: true},
 			},
-			countExpectedIntermediateQuery:  "WHERE name = $1",
-			countExpectedArgs:               []driver.Value{"fake"},
-			countResult:                     30,
-			selectExpectedIntermediateQuery: "WHERE name = $1 ORDER BY name DESC LIMIT 5 OFFSET 20",
-			selectExpectedArgs:              []driver.Value{"fake"},
+			selectExpectedIntermediateQuery: "WHERE (created_at, id) > ($1, $2) ORDER BY created_at ASC,id ASC LIMIT 2",
+			selectExpectedArgs:              []driver.Value{date1, "0"},
 			selectExpectedProjectionQuery:   "*",
-			want:                            &PageOutput{TotalRecord: 30, Limit: 5, Skip: 20, HasNext: true, HasPrevious: true},
+			want: &PageOutput{
+				Limit:       1,
+				HasNext:     true,
+				HasPrevious: true,
+				Cursors:     []*Cursor{defaultCursor("2", rawDate2)},
+			},
 		},
 		{
-			name: "extra function throwing error",
+			name: "extra function and projection",
 			args: args{
-				p: &PageInput{Limit: 5, Skip: 20},
+				p:          &PageInput{Limit: 5, WithoutCount: true},
+				sort:       &Sort{Name: &common.SortOrderEnumDesc},
+				projection: &Projection{Name: true},
 				extraFunc: func(db *gorm.DB) (*gorm.DB, error) {
-					return nil, errors.New("fake")
+					return db.Where("fake = ?", "fake1"), nil
 				},
 			},
-			wantErr: true,
+			selectExpectedIntermediateQuery: "WHERE fake = $1 ORDER BY name DESC,id DESC LIMIT 6",
+			selectExpectedArgs:              []driver.Value{"fake1"},
+			selectExpectedProjectionQuery:   `"name","id"`,
+			want: &PageOutput{
+				Limit:   5,
+				Cursors: []*Cursor{nameCursor("2", `"fake2"`), nameCursor("1", "null")},
+			},
 		},
 		{
-			name: "no sort, no filter, extra function with next and previous page and skip",
+			name: "extra function throwing error",
 			args: args{
-				p: &PageInput{Limit: 5, Skip: 20},
+				p: &PageInput{Limit: 5, WithoutCount: true},
 				extraFunc: func(db *gorm.DB) (*gorm.DB, error) {
-					return db.Where("fake = ?", "fake1"), nil
+					return nil, errors.New("fake")
 				},
 			},
-			countExpectedIntermediateQuery:  "WHERE fake = $1",
-			countExpectedArgs:               []driver.Value{"fake1"},
-			countResult:                     30,
-			selectExpectedIntermediateQuery: "WHERE fake = $1 ORDER BY created_at DESC LIMIT 5 OFFSET 20",
-			selectExpectedArgs:              []driver.Value{"fake1"},
-			selectExpectedProjectionQuery:   "*",
-			want:                            &PageOutput{TotalRecord: 30, Limit: 5, Skip: 20, HasNext: true, HasPrevious: true},
+			wantErr:     true,
+			errorString: "fake",
 		},
 		{
-			name: "sort, filter, projection, no extra function with next and previous page and skip",
+			name: "cursor not matching sort",
 			args: args{
-				p:          &PageInput{Limit: 5, Skip: 20},
-				sort:       &Sort{Name: &common.SortOrderEnumDesc},
-				filter:     &Filter{Name: &common.GenericFilter{Eq: "fake"}},
-				projection: &Projection{Name: true},
+				p:    &PageInput{Limit: 5, After: defaultCursor("1", rawDate1), WithoutCount: true},
+				sort: &Sort{Name: &common.SortOrderEnumDesc},
 			},
-			countExpectedIntermediateQuery:  "WHERE name = $1",
-			countExpectedArgs:               []driver.Value{"fake"},
-			countResult:                     30,
-			selectExpectedIntermediateQuery: "WHERE name = $1 ORDER BY name DESC LIMIT 5 OFFSET 20",
-			selectExpectedArgs:              []driver.Value{"fake"},
-			selectExpectedProjectionQuery:   `"name"`,
-			want:                            &PageOutput{TotalRecord: 30, Limit: 5, Skip: 20, HasNext: true, HasPrevious: true},
+			wantErr:     true,
+			errorString: "cursor doesn't match sort",
+		},
+		{
+			name: "cursor with wrong value type",
+			args: args{
+				p: &PageInput{Limit: 5, After: defaultCursor("1", []byte(`"fake"`)), WithoutCount: true},
+			},
+			wantErr:     true,
+			errorString: "cursor doesn't match sort",
+		},
+		{
+			name: "after and before cursors",
+			args: args{
+				p: &PageInput{Limit: 5, After: defaultCursor("1", rawDate1), Before: defaultCursor("1", rawDate1)},
+			},
+			wantErr:     true,
+			errorString: "after and before cursors can't be used together",
 		},
 	}
 	for _, tt := range tests {
@@ -177,18 +250,27 @@ func TestPaging(t *testing.T) {
 			selectExpectedQuery := `SELECT ` + tt.selectExpectedProjectionQuery +
 				` FROM "people" ` + tt.selectExpectedIntermediateQuery
 
-			mock.ExpectBegin()
-			mock.ExpectQuery(countExpectedQuery).
-				WithArgs(tt.countExpectedArgs...).
-				WillReturnRows(
-					sqlmock.NewRows([]string{"count"}).AddRow(tt.countResult),
-				)
+			if tt.withCount {
+				mock.ExpectBegin()
+				mock.ExpectQuery(countExpectedQuery).
+					WithArgs(tt.countExpectedArgs...).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"count"}).AddRow(tt.countResult),
+					)
+			}
+			if tt.estimatedExpectedQuery != "" {
+				mock.ExpectQuery(tt.estimatedExpectedQuery).
+					WithArgs(tt.estimatedExpectedArgs...).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"QUERY PLAN"}).AddRow(tt.estimatedPlan),
+					)
+			}
 			mock.ExpectQuery(selectExpectedQuery).
 				WithArgs(tt.selectExpectedArgs...).
-				WillReturnRows(
-					sqlmock.NewRows([]string{"name"}).AddRow("fake"),
-				)
-			mock.ExpectCommit()
+				WillReturnRows(rows())
+			if tt.withCount {
+				mock.ExpectCommit()
+			}
 
 			res := make([]*Person, 0)
 
@@ -204,114 +286,173 @@ func TestPaging(t *testing.T) {
 				t.Errorf("Paging() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Paging() = %v, want %v", got, tt.want)
+			if err != nil {
+				assert.Equal(t, tt.errorString, err.Error())
+				return
 			}
+
+			assert.Equal(t, tt.want, got)
+			assert.Len(t, res, len(tt.want.Cursors))
+			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func Test_getPageOutput(t *testing.T) {
-	type args struct {
-		p     *PageInput
-		count int64
+// fakeSQLStateError is an error with a PostgreSQL error code.
+type fakeSQLStateError struct{ code string }
+
+func (e *fakeSQLStateError) Error() string    { return "fake" }
+func (e *fakeSQLStateError) SQLState() string { return e.code }
+
+func TestPaging_regexFilter(t *testing.T) {
+	type Person struct {
+		ID        string
+		CreatedAt time.Time
+		Name      *string
+	}
+	type Filter struct {
+		Name *common.GenericFilter `dbfield:"name"`
+		OR   []*Filter
 	}
+	filter := &Filter{OR: []*Filter{{Name: &common.GenericFilter{Matches: "^fa+ke$"}}}}
+
 	tests := []struct {
-		name string
-		args args
-		want *PageOutput
+		name        string
+		selectErr   error
+		errorString string
 	}{
 		{
-			name: "no skip and count < limit",
-			args: args{
-				p: &PageInput{
-					Limit: 10,
-					Skip:  0,
-				},
-				count: 6,
-			},
-			want: &PageOutput{
-				TotalRecord: 6,
-				Limit:       10,
-				Skip:        0,
-				HasNext:     false,
-				HasPrevious: false,
-			},
+			name: "timeout is set",
 		},
 		{
-			name: "skip and count < limit",
-			args: args{
-				p: &PageInput{
-					Limit: 10,
-					Skip:  3,
-				},
-				count: 6,
-			},
-			want: &PageOutput{
-				TotalRecord: 6,
-				Limit:       10,
-				Skip:        3,
-				HasNext:     false,
-				HasPrevious: true,
-			},
+			name:        "canceled query",
+			selectErr:   &fakeSQLStateError{code: "57014"},
+			errorString: "query with regular expression filters took more than 10s, add more filters",
 		},
 		{
-			name: "skip and count < limit with more elements => previous page detected",
-			args: args{
-				p: &PageInput{
-					Limit: 10,
-					Skip:  25,
-				},
-				count: 30,
-			},
-			want: &PageOutput{
-				TotalRecord: 30,
-				Limit:       10,
-				Skip:        25,
-				HasNext:     false,
-				HasPrevious: true,
-			},
+			name:        "other error",
+			selectErr:   &fakeSQLStateError{code: "42601"},
+			errorString: "fake",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer sqlDB.Close()
+
+			db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{Logger: logger.Discard})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			// Timeout must be set in the same transaction as the query
+			mock.ExpectBegin()
+			mock.ExpectExec("SET LOCAL statement_timeout = 10000").WillReturnResult(sqlmock.NewResult(0, 0))
+			q := mock.ExpectQuery(`SELECT * FROM "people" WHERE name ~ $1 ORDER BY created_at DESC,id DESC LIMIT 6`).
+				WithArgs("^fa+ke$")
+			if tt.selectErr != nil {
+				q.WillReturnError(tt.selectErr)
+				mock.ExpectRollback()
+			} else {
+				q.WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "name"}))
+				mock.ExpectCommit()
+			}
+
+			res := make([]*Person, 0)
+
+			_, err = Paging(&res, &PagingOptions{
+				DB:        db,
+				PageInput: &PageInput{Limit: 5, WithoutCount: true},
+				Filter:    filter,
+			})
+			if tt.errorString != "" {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_buildSeekCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		cols      []*common.SortColumn
+		values    []interface{}
+		nullable  []bool
+		backward  bool
+		wantQuery string
+		wantArgs  []interface{}
+	}{
+		{
+			name:      "row comparison in descending order",
+			cols:      []*common.SortColumn{{Column: "a", Desc: true}, {Column: "id", Desc: true}},
+			values:    []interface{}{1, "1"},
+			nullable:  []bool{false, false},
+			wantQuery: "(a, id) < (?, ?)",
+			wantArgs:  []interface{}{1, "1"},
 		},
 		{
-			name: "no skip and count > limit => next page detected",
-			args: args{
-				p: &PageInput{
-					Limit: 10,
-					Skip:  0,
-				},
-				count: 15,
-			},
-			want: &PageOutput{
-				TotalRecord: 15,
-				Limit:       10,
-				Skip:        0,
-				HasNext:     true,
-				HasPrevious: false,
-			},
+			name:      "row comparison in descending order with backward pagination",
+			cols:      []*common.SortColumn{{Column: "a", Desc: true}, {Column: "id", Desc: true}},
+			values:    []interface{}{1, "1"},
+			nullable:  []bool{false, false},
+			backward:  true,
+			wantQuery: "(a, id) > (?, ?)",
+			wantArgs:  []interface{}{1, "1"},
 		},
 		{
-			name: "skip and count > limit => next and previous page detected",
-			args: args{
-				p: &PageInput{
-					Limit: 10,
-					Skip:  7,
-				},
-				count: 25,
-			},
-			want: &PageOutput{
-				TotalRecord: 25,
-				Limit:       10,
-				Skip:        7,
-				HasNext:     true,
-				HasPrevious: true,
-			},
+			name:      "mixed orders",
+			cols:      []*common.SortColumn{{Column: "a", Desc: true}, {Column: "id"}},
+			values:    []interface{}{1, "1"},
+			nullable:  []bool{false, false},
+			wantQuery: "((a < ?) OR (a = ? AND id > ?))",
+			wantArgs:  []interface{}{1, 1, "1"},
+		},
+		{
+			name:      "nullable ascending column",
+			cols:      []*common.SortColumn{{Column: "a"}, {Column: "id"}},
+			values:    []interface{}{1, "1"},
+			nullable:  []bool{true, false},
+			wantQuery: "(((a > ? OR a IS NULL)) OR (a = ? AND id > ?))",
+			wantArgs:  []interface{}{1, 1, "1"},
+		},
+		{
+			name:      "null value in ascending order",
+			cols:      []*common.SortColumn{{Column: "a"}, {Column: "id"}},
+			values:    []interface{}{nil, "1"},
+			nullable:  []bool{true, false},
+			wantQuery: "(a IS NULL AND id > ?)",
+			wantArgs:  []interface{}{"1"},
+		},
+		{
+			name:      "null value in descending order",
+			cols:      []*common.SortColumn{{Column: "a", Desc: true}, {Column: "b"}, {Column: "id", Desc: true}},
+			values:    []interface{}{nil, 2, "1"},
+			nullable:  []bool{true, false, false},
+			wantQuery: "((a IS NOT NULL) OR (a IS NULL AND b > ?) OR (a IS NULL AND b = ? AND id < ?))",
+			wantArgs:  []interface{}{2, 2, "1"},
+		},
+		{
+			name:      "nothing after",
+			cols:      []*common.SortColumn{{Column: "a"}},
+			values:    []interface{}{nil},
+			nullable:  []bool{true},
+			wantQuery: "FALSE",
+			wantArgs:  []interface{}{},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := getPageOutput(tt.args.p, tt.args.count)
-			assert.Equal(t, tt.want, got)
+			gotQuery, gotArgs := buildSeekCondition(tt.cols, tt.values, tt.nullable, tt.backward)
+			assert.Equal(t, tt.wantQuery, gotQuery)
+			assert.Equal(t, tt.wantArgs, gotArgs)
 		})
 	}
 }
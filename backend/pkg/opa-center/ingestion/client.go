@@ -0,0 +1,35 @@
+package ingestion
+
+import (
+	"context"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business"
+	dlmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+)
+
+// Client represents an ingestion client.
+type Client interface {
+	// Initialize will start workers and reload spooled payloads when asynchronous ingestion is enabled.
+	// Workers flush their current batch and stop when context is cancelled.
+	// Payloads are refused with a 503 status code once context is cancelled.
+	Initialize(ctx context.Context) error
+	// Wait will block until all workers are stopped.
+	Wait()
+	// IngestDecisionLogs will ingest a decision logs payload.
+	// Result will be nil when payload have been queued.
+	IngestDecisionLogs(logger log.Logger, partitionID string, body []byte) (*dlmodels.IngestionResult, error)
+	// IngestStatus will ingest a status payload.
+	IngestStatus(logger log.Logger, partitionID string, body []byte) error
+}
+
+// NewClient will generate a new ingestion client.
+// Asynchronous ingestion configuration is read only once, a restart is needed to apply changes.
+func NewClient(logger log.Logger, cfgManager config.Manager, busiServices *business.Services) Client {
+	return &service{
+		logger:       logger.WithField("component", "ingestion"),
+		cfg:          cfgManager.GetConfig().Center.AsyncIngestion,
+		busiServices: busiServices,
+	}
+}
@@ -0,0 +1,3 @@
+package ingestion
+
+// This package will manage decision logs and status payloads ingestion.
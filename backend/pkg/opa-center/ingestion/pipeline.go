@@ -0,0 +1,253 @@
+package ingestion
+
+import (
+	"context"
+	"time"
+
+	dlqmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	dlmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+)
+
+// Delay before retrying a flush that failed because of a temporary error.
+const retryDelay = 5 * time.Second
+
+func (s *service) enqueue(j *job) error {
+	// Check if pipeline is stopped
+	// Payloads must not be queued anymore because workers don't read the queue
+	if s.ctx.Err() != nil {
+		return errors.NewServiceUnavailableError("ingestion is stopped")
+	}
+
+	// Check if spool is enabled
+	if s.isSpoolEnabled() {
+		// Save job on disk before queuing it
+		err := s.spool(j)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Try to queue job without blocking
+	select {
+	case s.queue <- j:
+		return nil
+	default:
+		// Queue is full, remove spooled job as OPA will retry
+		s.unspool(j)
+
+		return errors.NewTooManyRequestsError("ingestion queue is full")
+	}
+}
+
+func (s *service) work(ctx context.Context) {
+	// Create ticker for flush
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	// Current batch of decision logs jobs
+	batch := make([]*job, 0)
+	// Number of decision logs in current batch
+	size := 0
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Add jobs already queued in order to not lose them on shutdown
+			batch = s.drainQueue(ctx, batch)
+			// Check if there is something to flush
+			if len(batch) != 0 {
+				s.flush(ctx, batch)
+			}
+
+			return
+		case j := <-s.queue:
+			// Check if it is a status job
+			// Those aren't batched
+			if j.Kind == dlqmodels.KindEnumStatus {
+				s.processStatusJob(ctx, j)
+
+				continue
+			}
+
+			// Add job to batch
+			batch = append(batch, j)
+			size += len(j.entries)
+
+			// Check if batch must be flushed
+			if size >= s.cfg.BatchSize {
+				s.flush(ctx, batch)
+				// Reset batch
+				batch = make([]*job, 0)
+				size = 0
+			}
+		case <-ticker.C:
+			// Check if there is something to flush
+			if len(batch) != 0 {
+				s.flush(ctx, batch)
+				// Reset batch
+				batch = make([]*job, 0)
+				size = 0
+			}
+		}
+	}
+}
+
+// drainQueue will add all queued decision logs jobs to batch without waiting.
+// Status jobs are processed directly.
+func (s *service) drainQueue(ctx context.Context, batch []*job) []*job {
+	for {
+		select {
+		case j := <-s.queue:
+			// Check if it is a status job
+			if j.Kind == dlqmodels.KindEnumStatus {
+				s.processStatusJob(ctx, j)
+
+				continue
+			}
+
+			batch = append(batch, j)
+		default:
+			return batch
+		}
+	}
+}
+
+// processStatusJob will store a status job and remove it from spool.
+func (s *service) processStatusJob(ctx context.Context, j *job) {
+	// Check if job have been processed
+	if s.retry(ctx, func() error { return s.processStatus(s.logger, j) }) {
+		s.unspool(j)
+	}
+}
+
+// retry will run function until it succeed or until payload is rejected.
+// It will return false when context is cancelled before, jobs must stay in spool in this case.
+func (s *service) retry(ctx context.Context, fn func() error) bool {
+	for {
+		// Run
+		err := fn()
+		// Check if it is a success or a rejection (already saved in dead letters)
+		if err == nil || isRejection(err) {
+			return true
+		}
+
+		s.logger.Errorf("Ingestion failed, retrying in %s", retryDelay)
+
+		// Wait before retrying or stop when pipeline is stopped
+		select {
+		case <-ctx.Done():
+			s.logger.Error("Ingestion failed during shutdown, payload kept in spool when enabled")
+
+			return false
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+func (s *service) flush(ctx context.Context, batch []*job) {
+	// Group jobs per partition
+	groups := map[string][]*job{}
+	// Keep order of partitions
+	partitionIDs := make([]string, 0)
+
+	// Loop over jobs
+	for _, j := range batch {
+		// Check if partition is already known
+		if _, ok := groups[j.PartitionID]; !ok {
+			partitionIDs = append(partitionIDs, j.PartitionID)
+		}
+
+		groups[j.PartitionID] = append(groups[j.PartitionID], j)
+	}
+
+	// Loop over partitions
+	for _, partitionID := range partitionIDs {
+		jobs := groups[partitionID]
+		// Flush partition jobs
+		if !s.retry(ctx, func() error { return s.flushPartition(ctx, partitionID, jobs) }) {
+			continue
+		}
+
+		// Remove spooled jobs
+		for _, j := range jobs {
+			s.unspool(j)
+		}
+	}
+}
+
+func (s *service) flushPartition(ctx context.Context, partitionID string, jobs []*job) error {
+	// Check if there is only one job
+	if len(jobs) == 1 {
+		_, err := s.processDecisionLogs(s.logger, jobs[0])
+
+		return err
+	}
+
+	// Merge all entries in one list and keep start offset of each job
+	entries := make([]map[string]interface{}, 0)
+	offsets := make([]int, 0, len(jobs))
+	// Loop over jobs
+	for _, j := range jobs {
+		offsets = append(offsets, len(entries))
+		entries = append(entries, j.entries...)
+	}
+
+	// Call service
+	res, err := s.busiServices.DecisionLogsSvc.UnsecureCreate(partitionID, entries)
+	// Check error
+	if err != nil {
+		// Check if it is a temporary error
+		if !isRejection(err) {
+			s.logger.Error(err)
+
+			return err
+		}
+
+		// Whole batch have been rejected, process jobs one by one in order to reject only invalid payloads
+		for _, j := range jobs {
+			j := j
+			// Process job
+			processed := s.retry(ctx, func() error {
+				_, err := s.processDecisionLogs(s.logger, j)
+
+				return err
+			})
+			// Check if pipeline have been stopped before
+			if !processed {
+				return ctx.Err()
+			}
+			// Remove job from spool in order to not process it again after a restart
+			s.unspool(j)
+		}
+
+		return nil
+	}
+
+	// Dispatch rejected entries to their original payloads
+	for i, j := range jobs {
+		// Compute end offset
+		end := len(entries)
+		if i+1 < len(jobs) {
+			end = offsets[i+1]
+		}
+
+		// Find rejected entries of this job
+		rejected := make([]*dlmodels.RejectedEntry, 0)
+		// Loop over rejected entries
+		for _, it := range res.Rejected {
+			// Check if entry is in job range
+			if it.Index >= offsets[i] && it.Index < end {
+				rejected = append(rejected, &dlmodels.RejectedEntry{Index: it.Index - offsets[i], Reason: it.Reason})
+			}
+		}
+
+		// Check if some entries have been rejected
+		if len(rejected) != 0 {
+			s.saveRejectedEntries(s.logger, j, rejected)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,403 @@
+//+build unit
+
+package ingestion
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business"
+	dlqmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/mocks"
+	dlqmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	dlmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/mocks"
+	dlmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	cerrors "github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDecisionLogsJob(t *testing.T, partitionID, body string) *job {
+	j := &job{Kind: dlqmodels.KindEnumDecisionLogs, PartitionID: partitionID, Body: []byte(body)}
+	assert.NoError(t, j.parse())
+
+	return j
+}
+
+func Test_service_enqueue(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spool")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s := &service{
+		logger: log.NewLogger(),
+		cfg:    &config.AsyncIngestionConfig{Enabled: true, SpoolDirectory: dir},
+		queue:  make(chan *job, 1),
+		ctx:    context.TODO(),
+	}
+
+	// First job is queued
+	j1 := newDecisionLogsJob(t, "p1", `[{"decision_id":"1"}]`)
+	assert.NoError(t, s.enqueue(j1))
+	assert.FileExists(t, j1.spoolFile)
+
+	// Second job is rejected because queue is full
+	j2 := newDecisionLogsJob(t, "p1", `[{"decision_id":"2"}]`)
+	err = s.enqueue(j2)
+	// nolint: errorlint // Ignore this because the aim is to catch project error at first level
+	err2, ok := err.(cerrors.Error)
+	if assert.True(t, ok) {
+		assert.Equal(t, http.StatusTooManyRequests, err2.StatusCode())
+	}
+	// Rejected job must not be reloaded after a restart
+	assert.NoFileExists(t, j2.spoolFile)
+
+	// Only first job is queued
+	assert.Len(t, s.queue, 1)
+	assert.Equal(t, j1, <-s.queue)
+}
+
+func Test_service_flushPartition(t *testing.T) {
+	tests := []struct {
+		name        string
+		jobs        []string
+		setup       func(dlSvcMock *dlmocks.MockService)
+		deadLetters map[string]string
+		wantErr     bool
+	}{
+		{
+			name: "single job",
+			jobs: []string{`[{"decision_id":"1"}]`},
+			setup: func(dlSvcMock *dlmocks.MockService) {
+				dlSvcMock.EXPECT().
+					UnsecureCreate("p1", []map[string]interface{}{{"decision_id": "1"}}).
+					Return(&dlmodels.IngestionResult{Accepted: 1}, nil)
+			},
+		},
+		{
+			name: "jobs merged and rejected entries dispatched to their payload",
+			jobs: []string{`[{"decision_id":"1"},{"decision_id":2}]`, `[{"decision_id":3},{"decision_id":"4"}]`},
+			setup: func(dlSvcMock *dlmocks.MockService) {
+				dlSvcMock.EXPECT().
+					UnsecureCreate("p1", []map[string]interface{}{
+						{"decision_id": "1"}, {"decision_id": float64(2)}, {"decision_id": float64(3)}, {"decision_id": "4"},
+					}).
+					Return(&dlmodels.IngestionResult{Accepted: 2, Rejected: []*dlmodels.RejectedEntry{
+						{Index: 1, Reason: "invalid 2"},
+						{Index: 2, Reason: "invalid 3"},
+					}}, nil)
+			},
+			deadLetters: map[string]string{
+				`[{"decision_id":2}]`: "index 1: invalid 2",
+				`[{"decision_id":3}]`: "index 0: invalid 3",
+			},
+		},
+		{
+			name: "merged batch rejected processes jobs one by one",
+			jobs: []string{`[{"decision_id":"1"}]`, `[{"decision_id":2}]`},
+			setup: func(dlSvcMock *dlmocks.MockService) {
+				gomock.InOrder(
+					dlSvcMock.EXPECT().
+						UnsecureCreate("p1", []map[string]interface{}{{"decision_id": "1"}, {"decision_id": float64(2)}}).
+						Return(nil, cerrors.NewInvalidInputError("some decision logs are invalid")),
+					dlSvcMock.EXPECT().
+						UnsecureCreate("p1", []map[string]interface{}{{"decision_id": "1"}}).
+						Return(&dlmodels.IngestionResult{Accepted: 1}, nil),
+					dlSvcMock.EXPECT().
+						UnsecureCreate("p1", []map[string]interface{}{{"decision_id": float64(2)}}).
+						Return(
+							&dlmodels.IngestionResult{Rejected: []*dlmodels.RejectedEntry{{Index: 0, Reason: "invalid 2"}}},
+							cerrors.NewInvalidInputError("some decision logs are invalid"),
+						),
+				)
+			},
+			deadLetters: map[string]string{
+				`[{"decision_id":2}]`: "index 0: invalid 2",
+			},
+		},
+		{
+			name: "temporary error",
+			jobs: []string{`[{"decision_id":"1"}]`, `[{"decision_id":"2"}]`},
+			setup: func(dlSvcMock *dlmocks.MockService) {
+				dlSvcMock.EXPECT().
+					UnsecureCreate("p1", gomock.Any()).
+					Return(nil, cerrors.NewInternalServerError("fake"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dlSvcMock := dlmocks.NewMockService(ctrl)
+			tt.setup(dlSvcMock)
+
+			dlqSvcMock := dlqmocks.NewMockService(ctrl)
+			// Saved dead letters indexed by body
+			saved := map[string]string{}
+			dlqSvcMock.EXPECT().
+				UnsecureCreate(dlqmodels.KindEnumDecisionLogs, "p1", gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ dlqmodels.KindEnum, _, reason string, bb []byte) error {
+					saved[string(bb)] = reason
+
+					return nil
+				}).
+				Times(len(tt.deadLetters))
+
+			s := &service{
+				logger: log.NewLogger(),
+				busiServices: &business.Services{
+					DecisionLogsSvc: dlSvcMock,
+					DeadLettersSvc:  dlqSvcMock,
+				},
+			}
+
+			jobs := make([]*job, 0, len(tt.jobs))
+			for _, b := range tt.jobs {
+				jobs = append(jobs, newDecisionLogsJob(t, "p1", b))
+			}
+
+			err := s.flushPartition(context.TODO(), "p1", jobs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("flushPartition() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(tt.deadLetters) != 0 {
+				assert.Equal(t, tt.deadLetters, saved)
+			}
+		})
+	}
+}
+
+func Test_service_work_flushOnShutdown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dlSvcMock := dlmocks.NewMockService(ctrl)
+	dlSvcMock.EXPECT().
+		UnsecureCreate("p1", []map[string]interface{}{{"decision_id": "1"}, {"decision_id": "2"}}).
+		Return(&dlmodels.IngestionResult{Accepted: 2}, nil)
+
+	s := &service{
+		logger:        log.NewLogger(),
+		cfg:           &config.AsyncIngestionConfig{Enabled: true, BatchSize: 100},
+		busiServices:  &business.Services{DecisionLogsSvc: dlSvcMock},
+		queue:         make(chan *job, 10),
+		flushInterval: time.Hour,
+	}
+
+	// Queue jobs that won't be flushed by size or interval
+	s.queue <- newDecisionLogsJob(t, "p1", `[{"decision_id":"1"}]`)
+	s.queue <- newDecisionLogsJob(t, "p1", `[{"decision_id":"2"}]`)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	// Worker must flush all queued jobs and stop
+	done := make(chan struct{})
+	go func() {
+		s.work(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("worker didn't stop")
+	}
+
+	assert.Len(t, s.queue, 0)
+}
+
+func Test_service_enqueue_stopped(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spool")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	s := &service{
+		logger: log.NewLogger(),
+		cfg:    &config.AsyncIngestionConfig{Enabled: true, SpoolDirectory: dir},
+		queue:  make(chan *job, 1),
+		ctx:    ctx,
+	}
+
+	err = s.enqueue(newDecisionLogsJob(t, "p1", `[{"decision_id":"1"}]`))
+	// nolint: errorlint // Ignore this because the aim is to catch project error at first level
+	err2, ok := err.(cerrors.Error)
+	if assert.True(t, ok) {
+		assert.Equal(t, http.StatusServiceUnavailable, err2.StatusCode())
+	}
+	assert.Len(t, s.queue, 0)
+
+	// Nothing must be spooled
+	files, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func Test_service_retry(t *testing.T) {
+	tests := []struct {
+		name      string
+		cancelled bool
+		errs      []error
+		want      bool
+		wantCalls int
+	}{
+		{
+			name:      "success",
+			errs:      []error{nil},
+			want:      true,
+			wantCalls: 1,
+		},
+		{
+			name:      "rejection isn't retried",
+			errs:      []error{cerrors.NewInvalidInputError("fake")},
+			want:      true,
+			wantCalls: 1,
+		},
+		{
+			name:      "temporary error isn't retried when pipeline is stopped",
+			cancelled: true,
+			errs:      []error{cerrors.NewInternalServerError("fake")},
+			wantCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+			if tt.cancelled {
+				cancel()
+			}
+
+			s := &service{logger: log.NewLogger()}
+
+			calls := 0
+			got := s.retry(ctx, func() error {
+				err := tt.errs[calls]
+				calls++
+
+				return err
+			})
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
+func Test_service_retry_cancelDuringWait(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+
+	s := &service{logger: log.NewLogger()}
+
+	done := make(chan bool)
+	go func() {
+		done <- s.retry(ctx, func() error { return cerrors.NewInternalServerError("fake") })
+	}()
+
+	cancel()
+
+	select {
+	case got := <-done:
+		assert.False(t, got)
+	case <-time.After(retryDelay / 2):
+		t.Fatal("retry didn't stop")
+	}
+}
+
+func Test_service_work_keepSpoolOnShutdownFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spool")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dlSvcMock := dlmocks.NewMockService(ctrl)
+	dlSvcMock.EXPECT().
+		UnsecureCreate("p1", gomock.Any()).
+		Return(nil, cerrors.NewInternalServerError("database is down"))
+
+	s := &service{
+		logger:        log.NewLogger(),
+		cfg:           &config.AsyncIngestionConfig{Enabled: true, BatchSize: 100, SpoolDirectory: dir},
+		busiServices:  &business.Services{DecisionLogsSvc: dlSvcMock},
+		queue:         make(chan *job, 10),
+		flushInterval: time.Hour,
+		ctx:           context.TODO(),
+	}
+
+	j := newDecisionLogsJob(t, "p1", `[{"decision_id":"1"}]`)
+	assert.NoError(t, s.enqueue(j))
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	// Worker must stop without retrying
+	done := make(chan struct{})
+	go func() {
+		s.work(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(retryDelay / 2):
+		t.Fatal("worker didn't stop")
+	}
+
+	// Job must be replayed after restart
+	assert.FileExists(t, j.spoolFile)
+}
+
+func Test_service_reloadSpool_stopped(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spool")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s := &service{
+		logger: log.NewLogger(),
+		cfg:    &config.AsyncIngestionConfig{Enabled: true, SpoolDirectory: dir},
+		queue:  make(chan *job, 1),
+	}
+
+	// Spool 2 jobs while queue can contain only one
+	for _, b := range []string{`[{"decision_id":"1"}]`, `[{"decision_id":"2"}]`} {
+		assert.NoError(t, s.spool(newDecisionLogsJob(t, "p1", b)))
+	}
+
+	ctx, cancel := context.WithCancel(context.TODO())
+
+	done := make(chan struct{})
+	go func() {
+		s.reloadSpool(ctx)
+		close(done)
+	}()
+
+	// Wait for first job then stop while replay is blocked on the full queue
+	for len(s.queue) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("spool replay didn't stop")
+	}
+
+	// Jobs stay on disk for next start
+	files, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+}
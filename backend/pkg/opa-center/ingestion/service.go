@@ -0,0 +1,316 @@
+package ingestion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business"
+	dlqmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	dlmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+)
+
+const spoolDirectoryPerm = 0700
+
+// Dead letter reason of valid entries that haven't been stored because payload have been rejected in strict mode.
+const strictRejectionReason = "valid entries not stored because other entries of payload are invalid in strict mode"
+
+type service struct {
+	logger        log.Logger
+	cfg           *config.AsyncIngestionConfig
+	busiServices  *business.Services
+	queue         chan *job
+	flushInterval time.Duration
+	workersWg     sync.WaitGroup
+	// Pipeline context cancelled on shutdown
+	ctx context.Context
+}
+
+type job struct {
+	Kind        dlqmodels.KindEnum `json:"kind"`
+	PartitionID string             `json:"partitionId"`
+	Body        json.RawMessage    `json:"body"`
+	// Parsed decision logs
+	entries []map[string]interface{}
+	// Parsed status
+	status map[string]interface{}
+	// Spool file path
+	spoolFile string
+}
+
+func (j *job) parse() error {
+	// Switch on kind
+	switch j.Kind {
+	case dlqmodels.KindEnumDecisionLogs:
+		return json.Unmarshal(j.Body, &j.entries)
+	case dlqmodels.KindEnumStatus:
+		return json.Unmarshal(j.Body, &j.status)
+	default:
+		return fmt.Errorf("kind %s not supported", j.Kind)
+	}
+}
+
+func (s *service) isAsync() bool {
+	return s.cfg != nil && s.cfg.Enabled
+}
+
+func (s *service) isSpoolEnabled() bool {
+	return s.isAsync() && s.cfg.SpoolDirectory != ""
+}
+
+func (s *service) Initialize(ctx context.Context) error {
+	// Check if asynchronous ingestion is enabled
+	if !s.isAsync() {
+		return nil
+	}
+
+	// Parse flush interval
+	flushInterval, err := time.ParseDuration(s.cfg.FlushInterval)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Save data
+	s.ctx = ctx
+	s.flushInterval = flushInterval
+	s.queue = make(chan *job, s.cfg.QueueSize)
+
+	// Check if spool is enabled
+	if s.isSpoolEnabled() {
+		// Create spool directory
+		err = os.MkdirAll(s.cfg.SpoolDirectory, spoolDirectoryPerm)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Start workers
+	for i := 0; i < s.cfg.Workers; i++ {
+		s.workersWg.Add(1)
+
+		go func() {
+			defer s.workersWg.Done()
+			s.work(ctx)
+		}()
+	}
+
+	// Check if spool is enabled
+	if s.isSpoolEnabled() {
+		// Reload spooled payloads in background to avoid blocking startup
+		go s.reloadSpool(ctx)
+	}
+
+	s.logger.Infof("Asynchronous ingestion started with %d workers", s.cfg.Workers)
+
+	return nil
+}
+
+func (s *service) Wait() {
+	s.workersWg.Wait()
+}
+
+func (s *service) IngestDecisionLogs(logger log.Logger, partitionID string, body []byte) (*dlmodels.IngestionResult, error) {
+	// Create job
+	j := &job{Kind: dlqmodels.KindEnumDecisionLogs, PartitionID: partitionID, Body: body}
+	// Parse body
+	err := j.parse()
+	// Check error
+	if err != nil {
+		// Save payload in dead letters
+		s.saveDeadLetter(logger, j, err.Error())
+
+		return nil, errors.NewInvalidInputErrorWithError(err)
+	}
+
+	// Check if asynchronous ingestion is enabled
+	if s.isAsync() {
+		return nil, s.enqueue(j)
+	}
+
+	return s.processDecisionLogs(logger, j)
+}
+
+func (s *service) IngestStatus(logger log.Logger, partitionID string, body []byte) error {
+	// Create job
+	j := &job{Kind: dlqmodels.KindEnumStatus, PartitionID: partitionID, Body: body}
+	// Parse body
+	err := j.parse()
+	// Check error
+	if err != nil {
+		// Save payload in dead letters
+		s.saveDeadLetter(logger, j, err.Error())
+
+		return errors.NewInvalidInputErrorWithError(err)
+	}
+
+	// Check if asynchronous ingestion is enabled
+	if s.isAsync() {
+		return s.enqueue(j)
+	}
+
+	return s.processStatus(logger, j)
+}
+
+func (s *service) processDecisionLogs(logger log.Logger, j *job) (*dlmodels.IngestionResult, error) {
+	// Call service
+	res, err := s.busiServices.DecisionLogsSvc.UnsecureCreate(j.PartitionID, j.entries)
+	// Check error
+	if err != nil {
+		logger.Error(err)
+		// Check if some entries have been rejected in strict mode
+		// Answer must be a success otherwise OPA will retry the same payload forever
+		if isRejection(err) && res != nil && len(res.Rejected) != 0 {
+			s.saveStrictRejection(logger, j, res.Rejected)
+
+			return res, nil
+		}
+		// Check if payload have been rejected
+		if isRejection(err) {
+			// Save payload in dead letters
+			s.saveDeadLetter(logger, j, err.Error())
+		}
+
+		return res, err
+	}
+
+	// Check if some entries have been rejected
+	if len(res.Rejected) != 0 {
+		s.saveRejectedEntries(logger, j, res.Rejected)
+	}
+
+	return res, nil
+}
+
+func (s *service) processStatus(logger log.Logger, j *job) error {
+	// Call service
+	err := s.busiServices.StatusSvc.UnsecureCreate(j.PartitionID, j.status)
+	// Check error
+	if err != nil {
+		logger.Error(err)
+		// Check if payload have been rejected
+		if isRejection(err) {
+			// Save payload in dead letters
+			s.saveDeadLetter(logger, j, err.Error())
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// saveRejectedEntries will store only rejected entries of a decision logs payload in dead letters.
+func (s *service) saveRejectedEntries(logger log.Logger, j *job, rejected []*dlmodels.RejectedEntry) {
+	logger.Warnf("%d decision logs have been rejected for partition %s", len(rejected), j.PartitionID)
+
+	// Create list of rejected entries
+	list := make([]map[string]interface{}, 0, len(rejected))
+	// Loop over rejected entries
+	for _, it := range rejected {
+		list = append(list, j.entries[it.Index])
+	}
+
+	// Marshal rejected entries
+	bb, err := json.Marshal(list)
+	// Check error
+	if err != nil {
+		logger.Error(err)
+
+		return
+	}
+
+	// Save only rejected entries in dead letters
+	s.saveDeadLetter(logger, &job{Kind: j.Kind, PartitionID: j.PartitionID, Body: bb}, formatRejectedReason(rejected))
+}
+
+// saveStrictRejection will store a decision logs payload rejected in strict mode in dead letters.
+// Invalid entries are stored with their reasons and valid entries are stored apart
+// in order to be replayed without any change.
+func (s *service) saveStrictRejection(logger log.Logger, j *job, rejected []*dlmodels.RejectedEntry) {
+	// Save invalid entries
+	s.saveRejectedEntries(logger, j, rejected)
+
+	// Index rejected entries
+	rejectedIndexes := map[int]bool{}
+	// Loop over rejected entries
+	for _, it := range rejected {
+		rejectedIndexes[it.Index] = true
+	}
+
+	// Create list of valid entries
+	list := make([]map[string]interface{}, 0, len(j.entries))
+	// Loop over entries
+	for i, it := range j.entries {
+		// Check if entry is valid
+		if !rejectedIndexes[i] {
+			list = append(list, it)
+		}
+	}
+
+	// Check if there is any valid entry
+	if len(list) == 0 {
+		return
+	}
+
+	// Marshal valid entries
+	bb, err := json.Marshal(list)
+	// Check error
+	if err != nil {
+		logger.Error(err)
+
+		return
+	}
+
+	// Save valid entries in dead letters
+	s.saveDeadLetter(
+		logger,
+		&job{Kind: j.Kind, PartitionID: j.PartitionID, Body: bb},
+		strictRejectionReason,
+	)
+}
+
+// saveDeadLetter will store a rejected payload in dead letters.
+// Errors are only logged in order to keep the original error.
+func (s *service) saveDeadLetter(logger log.Logger, j *job, reason string) {
+	// Call service
+	err := s.busiServices.DeadLettersSvc.UnsecureCreate(j.Kind, j.PartitionID, reason, j.Body)
+	// Check error
+	if err != nil {
+		logger.Error(err)
+
+		return
+	}
+
+	logger.Warnf("Rejected %s payload for partition %s saved in dead letters", j.Kind, j.PartitionID)
+}
+
+// isRejection will check if error is a rejection of the payload and not a temporary failure.
+func isRejection(err error) bool {
+	// Try to cast as common error
+	// nolint: errorlint // Ignore this because the aim is to catch project error at first level
+	err2, ok := err.(errors.Error)
+
+	return ok && err2.StatusCode() < http.StatusInternalServerError
+}
+
+// formatRejectedReason will create a dead letter reason from rejected entries.
+func formatRejectedReason(rejected []*dlmodels.RejectedEntry) string {
+	// Create list
+	res := make([]string, 0, len(rejected))
+	// Loop over rejected entries
+	for _, it := range rejected {
+		res = append(res, fmt.Sprintf("index %d: %s", it.Index, it.Reason))
+	}
+
+	return strings.Join(res, "\n")
+}
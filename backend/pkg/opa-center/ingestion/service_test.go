@@ -0,0 +1,223 @@
+//+build unit
+
+package ingestion
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business"
+	dlqmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/mocks"
+	dlqmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	dlmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/mocks"
+	dlmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	cerrors "github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isRejection(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "invalid input error",
+			err:  cerrors.NewInvalidInputError("fake"),
+			want: true,
+		},
+		{
+			name: "not found error",
+			err:  cerrors.NewNotFoundError("fake"),
+			want: true,
+		},
+		{
+			name: "internal server error",
+			err:  cerrors.NewInternalServerError("fake"),
+			want: false,
+		},
+		{
+			name: "non common error",
+			err:  errors.New("fake"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isRejection(tt.err))
+		})
+	}
+}
+
+func Test_formatRejectedReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		rejected []*dlmodels.RejectedEntry
+		want     string
+	}{
+		{
+			name:     "empty",
+			rejected: []*dlmodels.RejectedEntry{},
+			want:     "",
+		},
+		{
+			name: "multiple entries",
+			rejected: []*dlmodels.RejectedEntry{
+				{Index: 1, Reason: "path must be a string"},
+				{Index: 3, Reason: "timestamp must be a RFC3339 date"},
+			},
+			want: "index 1: path must be a string\nindex 3: timestamp must be a RFC3339 date",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, formatRejectedReason(tt.rejected))
+		})
+	}
+}
+
+func Test_job_parse(t *testing.T) {
+	tests := []struct {
+		name        string
+		j           *job
+		wantErr     bool
+		wantEntries []map[string]interface{}
+		wantStatus  map[string]interface{}
+	}{
+		{
+			name:        "decision logs",
+			j:           &job{Kind: dlqmodels.KindEnumDecisionLogs, Body: []byte(`[{"decision_id":"1"}]`)},
+			wantEntries: []map[string]interface{}{{"decision_id": "1"}},
+		},
+		{
+			name:    "decision logs with status body",
+			j:       &job{Kind: dlqmodels.KindEnumDecisionLogs, Body: []byte(`{"decision_id":"1"}`)},
+			wantErr: true,
+		},
+		{
+			name:       "status",
+			j:          &job{Kind: dlqmodels.KindEnumStatus, Body: []byte(`{"labels":{"id":"1"}}`)},
+			wantStatus: map[string]interface{}{"labels": map[string]interface{}{"id": "1"}},
+		},
+		{
+			name:    "invalid json",
+			j:       &job{Kind: dlqmodels.KindEnumStatus, Body: []byte(`{`)},
+			wantErr: true,
+		},
+		{
+			name:    "unknown kind",
+			j:       &job{Kind: "FAKE", Body: []byte(`{}`)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.j.parse()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil {
+				assert.Equal(t, tt.wantEntries, tt.j.entries)
+				assert.Equal(t, tt.wantStatus, tt.j.status)
+			}
+		})
+	}
+}
+
+func Test_service_processDecisionLogs(t *testing.T) {
+	body := []byte(`[{"decision_id":"1"},{"decision_id":2},{"decision_id":"3"}]`)
+	rejected := []*dlmodels.RejectedEntry{{Index: 1, Reason: "decision_id must be a string"}}
+
+	tests := []struct {
+		name        string
+		createRes   *dlmodels.IngestionResult
+		createErr   error
+		deadLetters map[string]string
+		wantRes     *dlmodels.IngestionResult
+		wantErr     bool
+	}{
+		{
+			name:      "success",
+			createRes: &dlmodels.IngestionResult{Accepted: 3, Rejected: []*dlmodels.RejectedEntry{}},
+			wantRes:   &dlmodels.IngestionResult{Accepted: 3, Rejected: []*dlmodels.RejectedEntry{}},
+		},
+		{
+			name:      "partial mode",
+			createRes: &dlmodels.IngestionResult{Accepted: 2, Rejected: rejected},
+			deadLetters: map[string]string{
+				`[{"decision_id":2}]`: "index 1: decision_id must be a string",
+			},
+			wantRes: &dlmodels.IngestionResult{Accepted: 2, Rejected: rejected},
+		},
+		{
+			name:      "strict mode answers a success and saves entries apart",
+			createRes: &dlmodels.IngestionResult{Rejected: rejected},
+			createErr: cerrors.NewInvalidInputError("some decision logs are invalid"),
+			deadLetters: map[string]string{
+				`[{"decision_id":2}]`:                       "index 1: decision_id must be a string",
+				`[{"decision_id":"1"},{"decision_id":"3"}]`: strictRejectionReason,
+			},
+			wantRes: &dlmodels.IngestionResult{Rejected: rejected},
+		},
+		{
+			name:      "partition not found",
+			createErr: cerrors.NewNotFoundError("partition doesn't exist"),
+			deadLetters: map[string]string{
+				string(body): "partition doesn't exist",
+			},
+			wantErr: true,
+		},
+		{
+			name:      "temporary error",
+			createErr: cerrors.NewInternalServerError("fake"),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			j := &job{Kind: dlqmodels.KindEnumDecisionLogs, PartitionID: "p1", Body: body}
+			assert.NoError(t, j.parse())
+
+			dlSvcMock := dlmocks.NewMockService(ctrl)
+			dlSvcMock.EXPECT().UnsecureCreate("p1", j.entries).Return(tt.createRes, tt.createErr)
+
+			dlqSvcMock := dlqmocks.NewMockService(ctrl)
+			// Saved dead letters indexed by body
+			saved := map[string]string{}
+			dlqSvcMock.EXPECT().
+				UnsecureCreate(dlqmodels.KindEnumDecisionLogs, "p1", gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ dlqmodels.KindEnum, _, reason string, bb []byte) error {
+					saved[string(bb)] = reason
+
+					return nil
+				}).
+				Times(len(tt.deadLetters))
+
+			s := &service{
+				logger: log.NewLogger(),
+				busiServices: &business.Services{
+					DecisionLogsSvc: dlSvcMock,
+					DeadLettersSvc:  dlqSvcMock,
+				},
+			}
+
+			got, err := s.processDecisionLogs(s.logger, j)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("processDecisionLogs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				assert.Equal(t, tt.wantRes, got)
+			}
+			if len(tt.deadLetters) != 0 {
+				assert.Equal(t, tt.deadLetters, saved)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package ingestion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+const spoolFilePerm = 0600
+
+// spool will save job on disk in order to survive a restart.
+func (s *service) spool(j *job) error {
+	// Generate unique id
+	u, err := uuid.NewV4()
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Marshal job
+	bb, err := json.Marshal(j)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Generate file path
+	// Timestamp prefix allows to reload files in order
+	fp := filepath.Join(s.cfg.SpoolDirectory, fmt.Sprintf("%d-%s.json", time.Now().UnixNano(), u.String()))
+
+	// Write file
+	err = ioutil.WriteFile(fp, bb, spoolFilePerm)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Save path
+	j.spoolFile = fp
+
+	return nil
+}
+
+// unspool will remove job from disk.
+func (s *service) unspool(j *job) {
+	// Check if job have been spooled
+	if j.spoolFile == "" {
+		return
+	}
+
+	// Remove file
+	err := os.Remove(j.spoolFile)
+	// Check error
+	if err != nil && !os.IsNotExist(err) {
+		s.logger.Error(err)
+	}
+}
+
+// reloadSpool will queue all jobs found on disk.
+// Replay stops when context is cancelled, remaining jobs stay on disk for next start.
+func (s *service) reloadSpool(ctx context.Context) {
+	// List files (sorted by name)
+	files, err := ioutil.ReadDir(s.cfg.SpoolDirectory)
+	// Check error
+	if err != nil {
+		s.logger.Error(err)
+
+		return
+	}
+
+	// Counter
+	count := 0
+
+	// Loop over files
+	for _, f := range files {
+		// Ignore directories
+		if f.IsDir() {
+			continue
+		}
+
+		// Get file path
+		fp := filepath.Join(s.cfg.SpoolDirectory, f.Name())
+
+		// Read file
+		bb, err := ioutil.ReadFile(fp)
+		// Check error
+		if err != nil {
+			s.logger.Error(err)
+
+			continue
+		}
+
+		// Parse job
+		j := &job{}
+		err = json.Unmarshal(bb, j)
+		// Check error
+		if err != nil {
+			s.logger.Errorf("Cannot parse spooled file %s: %v", fp, err)
+
+			continue
+		}
+
+		// Save path
+		j.spoolFile = fp
+
+		// Parse body
+		err = j.parse()
+		// Check error
+		if err != nil {
+			// Save payload in dead letters
+			s.saveDeadLetter(s.logger, j, err.Error())
+			s.unspool(j)
+
+			continue
+		}
+
+		// Queue job and wait for space or stop
+		select {
+		case s.queue <- j:
+			count++
+		case <-ctx.Done():
+			s.logger.Infof("%d spooled payloads reloaded before shutdown", count)
+
+			return
+		}
+	}
+
+	s.logger.Infof("%d spooled payloads reloaded", count)
+}
@@ -22,6 +22,8 @@ type Client interface {
 	DatabaseMiddleware(connectionName string) gorm.Plugin
 	// Get graphql middleware.
 	GraphqlMiddleware() gqlgraphql.HandlerExtension
+	// Increment counter of ingestion limits reached.
+	IncIngestionLimitReached(partitionName, limitName string)
 }
 
 // NewMetricsClient will generate a new Client.
@@ -21,6 +21,7 @@ type prometheusMetrics struct {
 	reqDur         *prometheus.SummaryVec
 	reqSz          *prometheus.SummaryVec
 	up             prometheus.Gauge
+	limitCnt       *prometheus.CounterVec
 	gormPrometheus map[string]gorm.Plugin
 }
 
@@ -53,6 +54,11 @@ func (ctx *prometheusMetrics) DatabaseMiddleware(connectionName string) gorm.Plu
 	return ctx.gormPrometheus[connectionName]
 }
 
+// IncIngestionLimitReached will increment counter of ingestion limits reached.
+func (ctx *prometheusMetrics) IncIngestionLimitReached(partitionName, limitName string) {
+	ctx.limitCnt.WithLabelValues(partitionName, limitName).Inc()
+}
+
 // Instrument will instrument gin routes.
 func (ctx *prometheusMetrics) Instrument(serverName string) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -147,6 +153,15 @@ func (ctx *prometheusMetrics) register() {
 	ctx.up.Set(1)
 	prometheus.MustRegister(ctx.up)
 
+	ctx.limitCnt = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ingestion_limit_reached_total",
+			Help: "How many OPA uploads have been refused because of an ingestion limit, partitioned by partition and limit.",
+		},
+		[]string{"partition", "limit"},
+	)
+	prometheus.MustRegister(ctx.limitCnt)
+
 	// Register gqlgen graphql prometheus metrics
 	gqlprometheus.Register()
 }
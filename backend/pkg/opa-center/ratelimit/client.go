@@ -0,0 +1,26 @@
+package ratelimit
+
+import (
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
+)
+
+// Client Ingestion limits client.
+type Client interface {
+	// Reload will reset all limiters in order to apply new configuration
+	Reload()
+	// GetMaxBodySize will return maximum body size in bytes allowed for partition (0 means no limit)
+	GetMaxBodySize(partition *pmodels.Partition) int64
+	// AllowRequest will check if a new request is allowed for partition
+	AllowRequest(partition *pmodels.Partition) bool
+	// AllowDecisions will check if n decisions are allowed for partition
+	AllowDecisions(partition *pmodels.Partition, n int) bool
+}
+
+// NewClient will generate a new ingestion limits client.
+func NewClient(cfgManager config.Manager) Client {
+	return &service{
+		cfgManager: cfgManager,
+		limiters:   map[string]*partitionLimiters{},
+	}
+}
@@ -0,0 +1,3 @@
+package ratelimit
+
+// This package will manage ingestion limits per partition.
@@ -0,0 +1,163 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
+	"golang.org/x/time/rate"
+)
+
+type service struct {
+	cfgManager config.Manager
+	limiters   map[string]*partitionLimiters
+	mutex      sync.Mutex
+}
+
+type partitionLimiters struct {
+	requests  *rate.Limiter
+	decisions *rate.Limiter
+}
+
+func (s *service) Reload() {
+	// Lock
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Reset limiters, they will be created again with new configuration
+	s.limiters = map[string]*partitionLimiters{}
+}
+
+func (s *service) GetMaxBodySize(partition *pmodels.Partition) int64 {
+	return s.getLimit(partition).MaxBodySize
+}
+
+func (s *service) AllowRequest(partition *pmodels.Partition) bool {
+	// Get limiter
+	l := s.getLimiters(partition).requests
+	// Check if there is a limit
+	if l == nil {
+		return true
+	}
+
+	return l.Allow()
+}
+
+func (s *service) AllowDecisions(partition *pmodels.Partition, n int) bool {
+	// Get limiter
+	l := s.getLimiters(partition).decisions
+	// Check if there is a limit
+	if l == nil {
+		return true
+	}
+
+	// Check if payload is larger than burst
+	// Such payload would never be allowed and OPA would retry it forever,
+	// so it is allowed when the whole burst is available and consumes it.
+	if n > l.Burst() {
+		n = l.Burst()
+	}
+
+	return l.AllowN(time.Now(), n)
+}
+
+// getLimiters will return limiters of partition.
+// Limiters are stored per partition name like limits configuration.
+// Partition names can't be changed and are reused only after a purge, so there is one entry per partition name.
+func (s *service) getLimiters(partition *pmodels.Partition) *partitionLimiters {
+	// Lock
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Check if limiters already exist
+	if res, ok := s.limiters[partition.Name]; ok {
+		return res
+	}
+
+	// Get limit
+	limit := s.getLimit(partition)
+	// Create limiters
+	res := &partitionLimiters{}
+
+	// Check if requests are limited
+	if limit.RequestsPerSecond > 0 {
+		// Burst is the number of requests allowed in one second
+		burst := int(math.Max(1, math.Ceil(limit.RequestsPerSecond)))
+		res.requests = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+	}
+
+	// Check if decisions are limited
+	if limit.DecisionsPerMinute > 0 {
+		// Burst is the number of decisions allowed in one minute
+		res.decisions = rate.NewLimiter(rate.Every(time.Minute/time.Duration(limit.DecisionsPerMinute)), limit.DecisionsPerMinute)
+	}
+
+	// Save
+	s.limiters[partition.Name] = res
+
+	return res
+}
+
+// getLimit will merge default limit with partition one.
+// Unlimited values are replaced by zero values meaning no limit.
+func (s *service) getLimit(partition *pmodels.Partition) *config.IngestionLimitConfig {
+	// Get merged limit
+	res := s.getMergedLimit(partition)
+
+	// Remove unlimited values
+	if res.RequestsPerSecond < 0 {
+		res.RequestsPerSecond = 0
+	}
+
+	if res.DecisionsPerMinute < 0 {
+		res.DecisionsPerMinute = 0
+	}
+
+	if res.MaxBodySize < 0 {
+		res.MaxBodySize = 0
+	}
+
+	return res
+}
+
+// getMergedLimit will merge default limit with partition one.
+func (s *service) getMergedLimit(partition *pmodels.Partition) *config.IngestionLimitConfig {
+	// Get configuration
+	cfg := s.cfgManager.GetConfig().Center.IngestionLimits
+	// Create result
+	res := &config.IngestionLimitConfig{}
+
+	// Check if there isn't any limit
+	if cfg == nil {
+		return res
+	}
+
+	// Check if default exists
+	if cfg.Default != nil {
+		*res = *cfg.Default
+	}
+
+	// Get partition limit
+	plimit := cfg.Partitions[partition.Name]
+	// Check if partition limit exists
+	if plimit == nil {
+		return res
+	}
+
+	// Override values
+	if plimit.RequestsPerSecond != 0 {
+		res.RequestsPerSecond = plimit.RequestsPerSecond
+	}
+
+	if plimit.DecisionsPerMinute != 0 {
+		res.DecisionsPerMinute = plimit.DecisionsPerMinute
+	}
+
+	if plimit.MaxBodySize != 0 {
+		res.MaxBodySize = plimit.MaxBodySize
+	}
+
+	return res
+}
@@ -0,0 +1,177 @@
+//+build unit
+
+package ratelimit
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
+	cmocks "github.com/oxyno-zeta/opa-center/pkg/opa-center/config/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_service_getLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       *config.IngestionLimitsConfig
+		partition *pmodels.Partition
+		want      *config.IngestionLimitConfig
+	}{
+		{
+			name:      "no limits",
+			partition: &pmodels.Partition{Name: "fake"},
+			want:      &config.IngestionLimitConfig{},
+		},
+		{
+			name: "default only",
+			cfg: &config.IngestionLimitsConfig{
+				Default: &config.IngestionLimitConfig{RequestsPerSecond: 1, DecisionsPerMinute: 2, MaxBodySize: 3},
+			},
+			partition: &pmodels.Partition{Name: "fake"},
+			want:      &config.IngestionLimitConfig{RequestsPerSecond: 1, DecisionsPerMinute: 2, MaxBodySize: 3},
+		},
+		{
+			name: "partition override",
+			cfg: &config.IngestionLimitsConfig{
+				Default: &config.IngestionLimitConfig{RequestsPerSecond: 1, DecisionsPerMinute: 2, MaxBodySize: 3},
+				Partitions: map[string]*config.IngestionLimitConfig{
+					"fake": {DecisionsPerMinute: 20},
+				},
+			},
+			partition: &pmodels.Partition{Name: "fake"},
+			want:      &config.IngestionLimitConfig{RequestsPerSecond: 1, DecisionsPerMinute: 20, MaxBodySize: 3},
+		},
+		{
+			name: "partition without limit",
+			cfg: &config.IngestionLimitsConfig{
+				Default: &config.IngestionLimitConfig{RequestsPerSecond: 1, DecisionsPerMinute: 2, MaxBodySize: 3},
+				Partitions: map[string]*config.IngestionLimitConfig{
+					"fake": {RequestsPerSecond: config.UnlimitedIngestionLimit, MaxBodySize: config.UnlimitedIngestionLimit},
+				},
+			},
+			partition: &pmodels.Partition{Name: "fake"},
+			want:      &config.IngestionLimitConfig{DecisionsPerMinute: 2},
+		},
+		{
+			name: "default without limit",
+			cfg: &config.IngestionLimitsConfig{
+				Default: &config.IngestionLimitConfig{DecisionsPerMinute: config.UnlimitedIngestionLimit},
+			},
+			partition: &pmodels.Partition{Name: "fake"},
+			want:      &config.IngestionLimitConfig{},
+		},
+		{
+			name: "other partition override",
+			cfg: &config.IngestionLimitsConfig{
+				Partitions: map[string]*config.IngestionLimitConfig{
+					"other": {DecisionsPerMinute: 20},
+				},
+			},
+			partition: &pmodels.Partition{Name: "fake"},
+			want:      &config.IngestionLimitConfig{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfgManagerMock := cmocks.NewMockManager(ctrl)
+			cfgManagerMock.EXPECT().GetConfig().Return(&config.Config{
+				Center: &config.CenterConfig{IngestionLimits: tt.cfg},
+			})
+
+			s := NewClient(cfgManagerMock).(*service)
+			assert.Equal(t, tt.want, s.getLimit(tt.partition))
+		})
+	}
+}
+
+func Test_service_Allow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfgManagerMock := cmocks.NewMockManager(ctrl)
+	cfgManagerMock.EXPECT().GetConfig().AnyTimes().Return(&config.Config{
+		Center: &config.CenterConfig{IngestionLimits: &config.IngestionLimitsConfig{
+			Default: &config.IngestionLimitConfig{RequestsPerSecond: 1, DecisionsPerMinute: 10},
+		}},
+	})
+
+	s := NewClient(cfgManagerMock)
+	partition := &pmodels.Partition{Name: "fake"}
+	partition.ID = "id"
+
+	// Requests
+	assert.True(t, s.AllowRequest(partition))
+	assert.False(t, s.AllowRequest(partition))
+
+	// Decisions
+	assert.True(t, s.AllowDecisions(partition, 8))
+	assert.False(t, s.AllowDecisions(partition, 8))
+	assert.True(t, s.AllowDecisions(partition, 2))
+
+	// Reload must reset limiters
+	s.Reload()
+	assert.True(t, s.AllowRequest(partition))
+	assert.True(t, s.AllowDecisions(partition, 10))
+}
+
+func Test_service_AllowDecisions_largerThanBurst(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfgManagerMock := cmocks.NewMockManager(ctrl)
+	cfgManagerMock.EXPECT().GetConfig().AnyTimes().Return(&config.Config{
+		Center: &config.CenterConfig{IngestionLimits: &config.IngestionLimitsConfig{
+			Default: &config.IngestionLimitConfig{DecisionsPerMinute: 10},
+		}},
+	})
+
+	s := NewClient(cfgManagerMock)
+	partition := &pmodels.Partition{Name: "fake"}
+	partition.ID = "id"
+
+	// Payload larger than burst is allowed when the whole burst is available
+	assert.True(t, s.AllowDecisions(partition, 50))
+	// And it consumes the whole burst
+	assert.False(t, s.AllowDecisions(partition, 1))
+	assert.False(t, s.AllowDecisions(partition, 50))
+}
+
+func Test_service_getLimiters_partitionName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfgManagerMock := cmocks.NewMockManager(ctrl)
+	cfgManagerMock.EXPECT().GetConfig().AnyTimes().Return(&config.Config{
+		Center: &config.CenterConfig{IngestionLimits: &config.IngestionLimitsConfig{
+			Default: &config.IngestionLimitConfig{RequestsPerSecond: 1},
+			Partitions: map[string]*config.IngestionLimitConfig{
+				"other": {RequestsPerSecond: config.UnlimitedIngestionLimit},
+			},
+		}},
+	})
+
+	s := NewClient(cfgManagerMock)
+
+	partition := &pmodels.Partition{Name: "fake"}
+	partition.ID = "id1"
+	// Partition created again with the same name after a purge
+	recreated := &pmodels.Partition{Name: "fake"}
+	recreated.ID = "id2"
+	other := &pmodels.Partition{Name: "other"}
+	other.ID = "id3"
+
+	// Limiters are shared by partitions with the same name
+	assert.True(t, s.AllowRequest(partition))
+	assert.False(t, s.AllowRequest(recreated))
+
+	// Other partition isn't limited
+	assert.True(t, s.AllowRequest(other))
+	assert.True(t, s.AllowRequest(other))
+
+	assert.Len(t, s.(*service).limiters, 2)
+}
@@ -0,0 +1,3 @@
+package dataloaders
+
+// This package will manage request scoped data loaders used to batch graphql resolvers requests
@@ -0,0 +1,55 @@
+package dataloaders
+
+import (
+	"context"
+	"time"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+)
+
+// Time to wait for other loads before sending a batch.
+const loaderWait = time.Millisecond
+
+// Maximum number of elements in a batch.
+const loaderMaxBatch = 100
+
+// contextKey is a value for use with context.WithValue.
+type contextKey struct {
+	name string
+}
+
+var loadersContextKey = &contextKey{name: "DATALOADERS_CONTEXT_KEY"}
+
+// Loaders represents all data loaders of a request.
+type Loaders struct {
+	PartitionLoader *PartitionLoader
+}
+
+// NewLoaders will create all data loaders for a request.
+// Context must be the request context because it is used to check authorizations.
+func NewLoaders(ctx context.Context, busiServices *business.Services) *Loaders {
+	return &Loaders{
+		PartitionLoader: NewPartitionLoader(
+			func(ids []string) ([]*models.Partition, []error) {
+				// Partitions are small, so they are loaded without projection
+				// in order to share results between all resolvers
+				return busiServices.PartitionsSvc.FindByIDs(ctx, ids, nil)
+			},
+			loaderWait,
+			loaderMaxBatch,
+		),
+	}
+}
+
+// SetLoadersToContext will set data loaders in context.
+func SetLoadersToContext(ctx context.Context, loaders *Loaders) context.Context {
+	return context.WithValue(ctx, loadersContextKey, loaders)
+}
+
+// GetLoadersFromContext will get data loaders from context.
+func GetLoadersFromContext(ctx context.Context) *Loaders {
+	res, _ := ctx.Value(loadersContextKey).(*Loaders)
+
+	return res
+}
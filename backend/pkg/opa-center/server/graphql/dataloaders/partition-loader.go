@@ -0,0 +1,160 @@
+package dataloaders
+
+import (
+	"sync"
+	"time"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+)
+
+// PartitionLoader will batch partition loads by id and cache results.
+// It must be used for only one request because results aren't refreshed.
+type PartitionLoader struct {
+	// Function used to fetch a batch of partitions.
+	// Results and errors must be in the same order as ids.
+	fetch func(ids []string) ([]*models.Partition, []error)
+	// Time to wait before sending a batch
+	wait time.Duration
+	// Maximum number of ids in a batch
+	maxBatch int
+
+	mutex sync.Mutex
+	// Loaded partitions indexed by id
+	cache map[string]*partitionResult
+	// Current batch
+	batch *partitionBatch
+}
+
+type partitionResult struct {
+	partition *models.Partition
+	err       error
+}
+
+type partitionBatch struct {
+	ids     []string
+	results []*models.Partition
+	errors  []error
+	closing bool
+	done    chan struct{}
+}
+
+// NewPartitionLoader will create a new partition loader.
+func NewPartitionLoader(
+	fetch func(ids []string) ([]*models.Partition, []error),
+	wait time.Duration,
+	maxBatch int,
+) *PartitionLoader {
+	return &PartitionLoader{
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+		cache:    map[string]*partitionResult{},
+	}
+}
+
+// Load will load a partition by id.
+// Calls made at the same time are grouped in one fetch.
+func (l *PartitionLoader) Load(id string) (*models.Partition, error) {
+	l.mutex.Lock()
+	// Check if partition is already loaded
+	if it, ok := l.cache[id]; ok {
+		l.mutex.Unlock()
+
+		return it.partition, it.err
+	}
+
+	// Check if a batch exists
+	if l.batch == nil {
+		l.batch = &partitionBatch{done: make(chan struct{})}
+	}
+	// Get batch
+	batch := l.batch
+	// Add id to batch
+	pos := batch.addID(l, id)
+	l.mutex.Unlock()
+
+	// Wait batch end
+	<-batch.done
+
+	// Get result
+	var partition *models.Partition
+	// Check if result exists
+	if pos < len(batch.results) {
+		partition = batch.results[pos]
+	}
+
+	// Get error
+	var err error
+	// Check if error exists
+	if pos < len(batch.errors) {
+		err = batch.errors[pos]
+	}
+
+	// Store result in cache
+	l.mutex.Lock()
+	l.cache[id] = &partitionResult{partition: partition, err: err}
+	l.mutex.Unlock()
+
+	return partition, err
+}
+
+// addID will add id in batch and return its position.
+// This must be called with loader lock.
+func (b *partitionBatch) addID(l *PartitionLoader, id string) int {
+	// Loop over ids to avoid duplicates
+	for i, existing := range b.ids {
+		// Check if id is already in batch
+		if existing == id {
+			return i
+		}
+	}
+
+	// Add id
+	pos := len(b.ids)
+	b.ids = append(b.ids, id)
+
+	// Check if this is the first id
+	if pos == 0 {
+		// Start timer
+		go b.startTimer(l)
+	}
+
+	// Check if batch is full
+	if l.maxBatch != 0 && pos >= l.maxBatch-1 {
+		// Check if batch isn't already closing
+		if !b.closing {
+			b.closing = true
+			// Remove batch from loader to start a new one
+			l.batch = nil
+			// Send batch
+			go b.end(l)
+		}
+	}
+
+	return pos
+}
+
+// startTimer will send batch after wait time if it isn't full before.
+func (b *partitionBatch) startTimer(l *PartitionLoader) {
+	time.Sleep(l.wait)
+	l.mutex.Lock()
+
+	// Check if batch have been already sent because it was full
+	if b.closing {
+		l.mutex.Unlock()
+
+		return
+	}
+
+	// Remove batch from loader to start a new one
+	l.batch = nil
+	l.mutex.Unlock()
+
+	b.end(l)
+}
+
+// end will fetch batch and notify waiting loads.
+func (b *partitionBatch) end(l *PartitionLoader) {
+	b.results, b.errors = l.fetch(b.ids)
+	close(b.done)
+}
@@ -0,0 +1,93 @@
+//+build unit
+
+package dataloaders
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionLoader(t *testing.T) {
+	// Fetched batches
+	var batches [][]string
+	// Lock on batches
+	var mutex sync.Mutex
+	fetch := func(ids []string) ([]*models.Partition, []error) {
+		mutex.Lock()
+		batches = append(batches, ids)
+		mutex.Unlock()
+
+		res := make([]*models.Partition, len(ids))
+		errs := make([]error, len(ids))
+		for i, id := range ids {
+			switch id {
+			case "forbidden":
+				errs[i] = errors.New("forbidden")
+			case "missing":
+			default:
+				res[i] = &models.Partition{Name: id}
+			}
+		}
+
+		return res, errs
+	}
+
+	t.Run("batch, deduplicate and cache", func(t *testing.T) {
+		batches = nil
+		l := NewPartitionLoader(fetch, 10*time.Millisecond, 100)
+
+		ids := []string{"p1", "p2", "p1", "forbidden", "missing"}
+		res := make([]*models.Partition, len(ids))
+		errs := make([]error, len(ids))
+		wg := sync.WaitGroup{}
+		for i, id := range ids {
+			wg.Add(1)
+			go func(i int, id string) {
+				defer wg.Done()
+				res[i], errs[i] = l.Load(id)
+			}(i, id)
+		}
+		wg.Wait()
+
+		assert.Len(t, batches, 1)
+		assert.ElementsMatch(t, []string{"p1", "p2", "forbidden", "missing"}, batches[0])
+		assert.Equal(t, &models.Partition{Name: "p1"}, res[0])
+		assert.Equal(t, &models.Partition{Name: "p2"}, res[1])
+		assert.Equal(t, &models.Partition{Name: "p1"}, res[2])
+		assert.EqualError(t, errs[3], "forbidden")
+		assert.Nil(t, res[4])
+		assert.NoError(t, errs[4])
+
+		// Load again from cache
+		p, err := l.Load("p2")
+		assert.NoError(t, err)
+		assert.Equal(t, &models.Partition{Name: "p2"}, p)
+		assert.Len(t, batches, 1)
+	})
+
+	t.Run("max batch", func(t *testing.T) {
+		batches = nil
+		l := NewPartitionLoader(fetch, time.Second, 2)
+
+		wg := sync.WaitGroup{}
+		for _, id := range []string{"p1", "p2", "p3", "p4"} {
+			wg.Add(1)
+			go func(id string) {
+				defer wg.Done()
+				p, err := l.Load(id)
+				assert.NoError(t, err)
+				assert.Equal(t, &models.Partition{Name: id}, p)
+			}(id)
+		}
+		wg.Wait()
+
+		assert.Len(t, batches, 2)
+		assert.Len(t, batches[0], 2)
+		assert.Len(t, batches[1], 2)
+	})
+}
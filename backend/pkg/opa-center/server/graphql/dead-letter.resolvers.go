@@ -0,0 +1,57 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	models1 "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/generated"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/mappers"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/utils"
+)
+
+func (r *deadLetterResolver) ID(ctx context.Context, obj *models.DeadLetter) (string, error) {
+	return utils.ToIDRelay(mappers.DeadLetterIDPrefix, obj.ID), nil
+}
+
+func (r *deadLetterResolver) CreatedAt(ctx context.Context, obj *models.DeadLetter) (string, error) {
+	return utils.FormatTime(obj.CreatedAt), nil
+}
+
+func (r *deadLetterResolver) UpdatedAt(ctx context.Context, obj *models.DeadLetter) (string, error) {
+	return utils.FormatTime(obj.UpdatedAt), nil
+}
+
+func (r *deadLetterResolver) ReplayedAt(ctx context.Context, obj *models.DeadLetter) (*string, error) {
+	// Check if dead letter have been replayed
+	if obj.ReplayedAt == nil {
+		return nil, nil
+	}
+
+	// Format time
+	res := utils.FormatTime(*obj.ReplayedAt)
+
+	return &res, nil
+}
+
+func (r *deadLetterResolver) Partition(ctx context.Context, obj *models.DeadLetter) (*models1.Partition, error) {
+	// Create projection object
+	projection := models1.Projection{}
+	// Get projection
+	err := utils.ManageSimpleProjection(ctx, &projection)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Call business
+	return r.BusiServices.PartitionsSvc.FindByID(ctx, obj.PartitionID, &projection)
+}
+
+// DeadLetter returns generated.DeadLetterResolver implementation.
+func (r *Resolver) DeadLetter() generated.DeadLetterResolver { return &deadLetterResolver{r} }
+
+type deadLetterResolver struct{ *Resolver }
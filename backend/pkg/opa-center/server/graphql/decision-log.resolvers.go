@@ -8,6 +8,7 @@ import (
 
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
 	models1 "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/dataloaders"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/generated"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/mappers"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/utils"
@@ -30,17 +31,8 @@ func (r *decisionLogResolver) Timestamp(ctx context.Context, obj *models.Decisio
 }
 
 func (r *decisionLogResolver) Partition(ctx context.Context, obj *models.DecisionLog) (*models1.Partition, error) {
-	// Create projection object
-	projection := models1.Projection{}
-	// Get projection
-	err := utils.ManageSimpleProjection(ctx, &projection)
-	// Check error
-	if err != nil {
-		return nil, err
-	}
-
-	// Call business
-	return r.BusiServices.PartitionsSvc.FindByID(ctx, obj.PartitionID, &projection)
+	// Load partition with request data loader in order to batch requests on a page
+	return dataloaders.GetLoadersFromContext(ctx).PartitionLoader.Load(obj.PartitionID)
 }
 
 // DecisionLog returns generated.DecisionLogResolver implementation.
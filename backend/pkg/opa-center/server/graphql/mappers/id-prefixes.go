@@ -3,3 +3,5 @@ package mappers
 const PartitionIDPrefix = "partitions"
 const DecisionLogIDPrefix = "decision-logs"
 const StatusIDPrefix = "statuses"
+const DeadLetterIDPrefix = "dead-letters"
+const PartitionTokenIDPrefix = "partition-tokens"
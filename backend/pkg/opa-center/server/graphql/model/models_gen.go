@@ -3,42 +3,91 @@
 package model
 
 import (
-	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
-	models1 "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
-	models2 "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/models"
+	models1 "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
+	models2 "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	models3 "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/utils"
 )
 
+type CreatePartitionPayload struct {
+	Partition *models.Partition `json:"partition"`
+	// Default token, this is the only time its value is available
+	PartitionToken *models.PartitionToken `json:"partitionToken"`
+}
+
+type DeadLetterConnection struct {
+	Edges    []*DeadLetterEdge `json:"edges"`
+	PageInfo *utils.PageInfo   `json:"pageInfo"`
+	// Exact number of elements matching filters (computed only when requested).
+	// This can be slow on large tables.
+	TotalCount int64 `json:"totalCount"`
+	// Number of elements matching filters estimated by the database planner (computed only when requested).
+	// This is fast but relies on table statistics.
+	EstimatedCount int64 `json:"estimatedCount"`
+}
+
+type DeadLetterEdge struct {
+	Cursor string              `json:"cursor"`
+	Node   *models1.DeadLetter `json:"node"`
+}
+
 type DecisionLogConnection struct {
 	Edges    []*DecisionLogEdge `json:"edges"`
 	PageInfo *utils.PageInfo    `json:"pageInfo"`
+	// Exact number of elements matching filters (computed only when requested).
+	// This can be slow on large tables.
+	TotalCount int64 `json:"totalCount"`
+	// Number of elements matching filters estimated by the database planner (computed only when requested).
+	// This is fast but relies on table statistics.
+	EstimatedCount int64 `json:"estimatedCount"`
 }
 
 type DecisionLogEdge struct {
-	Cursor string              `json:"cursor"`
-	Node   *models.DecisionLog `json:"node"`
+	Cursor string               `json:"cursor"`
+	Node   *models2.DecisionLog `json:"node"`
+}
+
+type GenericDeadLetterPayload struct {
+	DeadLetter *models1.DeadLetter `json:"deadLetter"`
 }
 
 type GenericPartitionPayload struct {
-	Partition *models1.Partition `json:"partition"`
+	Partition *models.Partition `json:"partition"`
+}
+
+type GenericPartitionTokenPayload struct {
+	PartitionToken *models.PartitionToken `json:"partitionToken"`
 }
 
 type PartitionConnection struct {
 	Edges    []*PartitionEdge `json:"edges"`
 	PageInfo *utils.PageInfo  `json:"pageInfo"`
+	// Exact number of elements matching filters (computed only when requested).
+	// This can be slow on large tables.
+	TotalCount int64 `json:"totalCount"`
+	// Number of elements matching filters estimated by the database planner (computed only when requested).
+	// This is fast but relies on table statistics.
+	EstimatedCount int64 `json:"estimatedCount"`
 }
 
 type PartitionEdge struct {
-	Cursor string             `json:"cursor"`
-	Node   *models1.Partition `json:"node"`
+	Cursor string            `json:"cursor"`
+	Node   *models.Partition `json:"node"`
 }
 
 type StatusConnection struct {
 	Edges    []*StatusEdge   `json:"edges"`
 	PageInfo *utils.PageInfo `json:"pageInfo"`
+	// Exact number of elements matching filters (computed only when requested).
+	// This can be slow on large tables.
+	TotalCount int64 `json:"totalCount"`
+	// Number of elements matching filters estimated by the database planner (computed only when requested).
+	// This is fast but relies on table statistics.
+	EstimatedCount int64 `json:"estimatedCount"`
 }
 
 type StatusEdge struct {
 	Cursor string          `json:"cursor"`
-	Node   *models2.Status `json:"node"`
+	Node   *models3.Status `json:"node"`
 }
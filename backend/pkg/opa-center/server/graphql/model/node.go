@@ -0,0 +1,6 @@
+package model
+
+// Node represents an object with a global id.
+// Business models are used directly as graphql objects, so this interface is empty
+// in order to avoid graphql methods in business models.
+type Node interface{}
@@ -0,0 +1,97 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/mappers"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/model"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/utils"
+)
+
+// findNodes will find objects from their relay ids.
+// An object that cannot be found is returned as null with an error on its path
+// in order to return other objects.
+func (r *Resolver) findNodes(ctx context.Context, relayIDs []string) []model.Node {
+	// Create result
+	res := make([]model.Node, 0, len(relayIDs))
+	// Loop over ids
+	for i, relayID := range relayIDs {
+		// Find object
+		n, err := r.findNode(ctx, relayID)
+		// Check error
+		if err != nil {
+			// Add error on element path
+			gqlgraphql.AddError(gqlgraphql.WithPathContext(ctx, gqlgraphql.NewPathWithIndex(i)), err)
+		}
+		// Append
+		res = append(res, n)
+	}
+
+	return res
+}
+
+// findNode will find an object from its relay id.
+// Object type is given by the id prefix and business services are in charge of authorization checks.
+// Objects are loaded without projection because fields can be asked in fragments on any type.
+func (r *Resolver) findNode(ctx context.Context, relayID string) (model.Node, error) {
+	// Decode relay id
+	prefix, id, err := utils.DecodeIDRelay(relayID)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Find object depending on prefix
+	switch prefix {
+	case mappers.PartitionIDPrefix:
+		// Find partition
+		res, err := r.BusiServices.PartitionsSvc.FindByID(ctx, id, nil)
+		// Check error or not found in order to avoid a typed nil
+		if err != nil || res == nil {
+			return nil, err
+		}
+
+		return res, nil
+	case mappers.PartitionTokenIDPrefix:
+		// Find partition token
+		res, err := r.BusiServices.PartitionsSvc.FindTokenByID(ctx, id)
+		// Check error or not found in order to avoid a typed nil
+		if err != nil || res == nil {
+			return nil, err
+		}
+
+		return res, nil
+	case mappers.DecisionLogIDPrefix:
+		// Find decision log
+		res, err := r.BusiServices.DecisionLogsSvc.FindByIDOrDecisionID(ctx, &id, nil, nil)
+		// Check error or not found in order to avoid a typed nil
+		if err != nil || res == nil {
+			return nil, err
+		}
+
+		return res, nil
+	case mappers.StatusIDPrefix:
+		// Find status
+		res, err := r.BusiServices.StatusSvc.FindByID(ctx, id, nil)
+		// Check error or not found in order to avoid a typed nil
+		if err != nil || res == nil {
+			return nil, err
+		}
+
+		return res, nil
+	case mappers.DeadLetterIDPrefix:
+		// Find dead letter
+		res, err := r.BusiServices.DeadLettersSvc.FindByID(ctx, id, nil)
+		// Check error or not found in order to avoid a typed nil
+		if err != nil || res == nil {
+			return nil, err
+		}
+
+		return res, nil
+	default:
+		return nil, errors.NewInvalidInputError(fmt.Sprintf("unsupported relay prefix %s", prefix))
+	}
+}
@@ -0,0 +1,56 @@
+//+build unit
+
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+	"github.com/golang/mock/gomock"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/mocks"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/mappers"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/model"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestResolver_findNodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	status := &models.Status{}
+	status.ID = "status1"
+
+	statusSvc := mocks.NewMockService(ctrl)
+	statusSvc.EXPECT().FindByID(gomock.Any(), "status1", nil).Return(status, nil)
+	statusSvc.EXPECT().FindByID(gomock.Any(), "status2", nil).Return(nil, errors.NewForbiddenError("forbidden"))
+
+	r := &Resolver{BusiServices: &business.Services{StatusSvc: statusSvc}}
+
+	ctx := gqlgraphql.WithResponseContext(
+		context.TODO(),
+		gqlgraphql.DefaultErrorPresenter,
+		gqlgraphql.DefaultRecover,
+	)
+
+	got := r.findNodes(ctx, []string{
+		utils.ToIDRelay(mappers.StatusIDPrefix, "status1"),
+		utils.ToIDRelay(mappers.StatusIDPrefix, "status2"),
+		utils.ToIDRelay("unknown", "id"),
+	})
+
+	assert.Equal(t, []model.Node{status, nil, nil}, got)
+
+	errs := gqlgraphql.GetErrors(ctx)
+	if assert.Len(t, errs, 2) {
+		assert.Equal(t, ast.Path{ast.PathIndex(1)}, errs[0].Path)
+		assert.Equal(t, "forbidden", errs[0].Message)
+		assert.Equal(t, ast.Path{ast.PathIndex(2)}, errs[1].Path)
+		assert.Equal(t, "unsupported relay prefix unknown", errs[1].Message)
+	}
+}
@@ -5,6 +5,8 @@ package graphql
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	models2 "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
@@ -27,11 +29,49 @@ func (r *partitionResolver) UpdatedAt(ctx context.Context, obj *models.Partition
 	return utils.FormatTime(obj.UpdatedAt), nil
 }
 
+func (r *partitionResolver) DeletedAt(ctx context.Context, obj *models.Partition) (*string, error) {
+	// Check if partition have been deleted
+	if !obj.DeletedAt.Valid {
+		return nil, nil
+	}
+
+	// Format time
+	res := utils.FormatTime(obj.DeletedAt.Time)
+
+	return &res, nil
+}
+
+func (r *partitionResolver) Labels(ctx context.Context, obj *models.Partition) ([]*models.Label, error) {
+	// Create result
+	res := make([]*models.Label, 0, len(obj.Labels))
+	// Loop over labels
+	for k, v := range obj.Labels {
+		res = append(res, &models.Label{Key: k, Value: v})
+	}
+	// Sort by key in order to have a stable order
+	sort.Slice(res, func(i, j int) bool { return res[i].Key < res[j].Key })
+
+	return res, nil
+}
+
+func (r *partitionResolver) Owners(ctx context.Context, obj *models.Partition) ([]string, error) {
+	// Check if owners are set
+	if obj.Owners == nil {
+		return []string{}, nil
+	}
+
+	return obj.Owners, nil
+}
+
 func (r *partitionResolver) OpaConfiguration(ctx context.Context, obj *models.Partition) (string, error) {
 	return r.BusiServices.PartitionsSvc.GenerateOPAConfiguration(ctx, obj.ID)
 }
 
-func (r *partitionResolver) Statuses(ctx context.Context, obj *models.Partition, after *string, before *string, first *int, last *int, sort *models1.SortOrder, filter *models1.Filter) (*model.StatusConnection, error) {
+func (r *partitionResolver) Tokens(ctx context.Context, obj *models.Partition) ([]*models.PartitionToken, error) {
+	return r.BusiServices.PartitionsSvc.GetTokens(ctx, obj.ID)
+}
+
+func (r *partitionResolver) Statuses(ctx context.Context, obj *models.Partition, after *string, before *string, first *int, last *int, sort *models1.SortOrder, filter *models1.Filter, query *string) (*model.StatusConnection, error) {
 	// Create projection object
 	projection := models1.Projection{}
 	// Get projection
@@ -49,9 +89,15 @@ func (r *partitionResolver) Statuses(ctx context.Context, obj *models.Partition,
 	if err != nil {
 		return nil, err
 	}
+	// Compute counts only when they are asked
+	err = utils.ManageConnectionCountProjection(ctx, pInput)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
 
 	// Call business
-	list, pOut, err := r.BusiServices.StatusSvc.GetAllPaginated(ctx, obj.ID, pInput, sort, filter, &projection)
+	list, pOut, err := r.BusiServices.StatusSvc.GetAllPaginated(ctx, obj.ID, pInput, sort, filter, &projection, query)
 	// Check error
 	if err != nil {
 		return nil, err
@@ -69,7 +115,7 @@ func (r *partitionResolver) Statuses(ctx context.Context, obj *models.Partition,
 	return &res, nil
 }
 
-func (r *partitionResolver) DecisionLogs(ctx context.Context, obj *models.Partition, after *string, before *string, first *int, last *int, sort *models2.SortOrder, filter *models2.Filter) (*model.DecisionLogConnection, error) {
+func (r *partitionResolver) DecisionLogs(ctx context.Context, obj *models.Partition, after *string, before *string, first *int, last *int, sort *models2.SortOrder, filter *models2.Filter, search *string, query *string) (*model.DecisionLogConnection, error) {
 	// Create projection object
 	projection := models2.Projection{}
 	// Get projection
@@ -87,9 +133,15 @@ func (r *partitionResolver) DecisionLogs(ctx context.Context, obj *models.Partit
 	if err != nil {
 		return nil, err
 	}
+	// Compute counts only when they are asked
+	err = utils.ManageConnectionCountProjection(ctx, pInput)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
 
 	// Call business
-	list, pOut, err := r.BusiServices.DecisionLogsSvc.GetAllPaginated(ctx, obj.ID, pInput, sort, filter, &projection)
+	list, pOut, err := r.BusiServices.DecisionLogsSvc.GetAllPaginated(ctx, obj.ID, pInput, sort, filter, &projection, search, query)
 	// Check error
 	if err != nil {
 		return nil, err
@@ -107,7 +159,62 @@ func (r *partitionResolver) DecisionLogs(ctx context.Context, obj *models.Partit
 	return &res, nil
 }
 
+func (r *partitionTokenResolver) ID(ctx context.Context, obj *models.PartitionToken) (string, error) {
+	return utils.ToIDRelay(mappers.PartitionTokenIDPrefix, obj.ID), nil
+}
+
+func (r *partitionTokenResolver) CreatedAt(ctx context.Context, obj *models.PartitionToken) (string, error) {
+	return utils.FormatTime(obj.CreatedAt), nil
+}
+
+func (r *partitionTokenResolver) UpdatedAt(ctx context.Context, obj *models.PartitionToken) (string, error) {
+	return utils.FormatTime(obj.UpdatedAt), nil
+}
+
+func (r *partitionTokenResolver) Value(ctx context.Context, obj *models.PartitionToken) (*string, error) {
+	// Check if value is available (only on creation or rotation)
+	if obj.Value == "" {
+		return nil, nil
+	}
+
+	return &obj.Value, nil
+}
+
+func (r *partitionTokenResolver) ExpiresAt(ctx context.Context, obj *models.PartitionToken) (*string, error) {
+	// Check if token expires
+	if obj.ExpiresAt == nil {
+		return nil, nil
+	}
+
+	// Format time
+	res := utils.FormatTime(*obj.ExpiresAt)
+
+	return &res, nil
+}
+
+func (r *partitionTokenResolver) RevokedAt(ctx context.Context, obj *models.PartitionToken) (*string, error) {
+	// Check if token have been revoked
+	if obj.RevokedAt == nil {
+		return nil, nil
+	}
+
+	// Format time
+	res := utils.FormatTime(*obj.RevokedAt)
+
+	return &res, nil
+}
+
+func (r *partitionTokenResolver) Active(ctx context.Context, obj *models.PartitionToken) (bool, error) {
+	return obj.IsActive(time.Now()), nil
+}
+
 // Partition returns generated.PartitionResolver implementation.
 func (r *Resolver) Partition() generated.PartitionResolver { return &partitionResolver{r} }
 
+// PartitionToken returns generated.PartitionTokenResolver implementation.
+func (r *Resolver) PartitionToken() generated.PartitionTokenResolver {
+	return &partitionTokenResolver{r}
+}
+
 type partitionResolver struct{ *Resolver }
+type partitionTokenResolver struct{ *Resolver }
@@ -6,6 +6,7 @@ package graphql
 import (
 	"context"
 
+	models2 "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/deadletters/models"
 	models1 "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/decisionlogs/models"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
 	models3 "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/models"
@@ -15,15 +16,15 @@ import (
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/utils"
 )
 
-func (r *mutationResolver) CreatePartition(ctx context.Context, input models.CreateInput) (*model.GenericPartitionPayload, error) {
+func (r *mutationResolver) CreatePartition(ctx context.Context, input models.CreateInput) (*model.CreatePartitionPayload, error) {
 	// Call business
-	part, err := r.BusiServices.PartitionsSvc.Create(ctx, &input)
+	part, tok, err := r.BusiServices.PartitionsSvc.Create(ctx, &input)
 	// Check error
 	if err != nil {
 		return nil, err
 	}
 
-	return &model.GenericPartitionPayload{Partition: part}, nil
+	return &model.CreatePartitionPayload{Partition: part, PartitionToken: tok}, nil
 }
 
 func (r *mutationResolver) UpdatePartition(ctx context.Context, input models.UpdateInput) (*model.GenericPartitionPayload, error) {
@@ -46,6 +47,154 @@ func (r *mutationResolver) UpdatePartition(ctx context.Context, input models.Upd
 	return &model.GenericPartitionPayload{Partition: part}, nil
 }
 
+func (r *mutationResolver) DeletePartition(ctx context.Context, input models.DeleteInput) (*model.GenericPartitionPayload, error) {
+	// Transform relay id to id
+	id, err := utils.FromIDRelay(input.ID, mappers.PartitionIDPrefix)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Override data
+	input.ID = id
+
+	// Call business
+	part, err := r.BusiServices.PartitionsSvc.Delete(ctx, &input)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GenericPartitionPayload{Partition: part}, nil
+}
+
+func (r *mutationResolver) RestorePartition(ctx context.Context, input models.RestoreInput) (*model.GenericPartitionPayload, error) {
+	// Transform relay id to id
+	id, err := utils.FromIDRelay(input.ID, mappers.PartitionIDPrefix)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Override data
+	input.ID = id
+
+	// Call business
+	part, err := r.BusiServices.PartitionsSvc.Restore(ctx, &input)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GenericPartitionPayload{Partition: part}, nil
+}
+
+func (r *mutationResolver) PurgePartition(ctx context.Context, input models.PurgeInput) (*model.GenericPartitionPayload, error) {
+	// Transform relay id to id
+	id, err := utils.FromIDRelay(input.ID, mappers.PartitionIDPrefix)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Override data
+	input.ID = id
+
+	// Call business
+	part, err := r.BusiServices.PartitionsSvc.Purge(ctx, &input)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GenericPartitionPayload{Partition: part}, nil
+}
+
+func (r *mutationResolver) CreatePartitionToken(ctx context.Context, input models.CreateTokenInput) (*model.GenericPartitionTokenPayload, error) {
+	// Transform relay id to id
+	id, err := utils.FromIDRelay(input.PartitionID, mappers.PartitionIDPrefix)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Override data
+	input.PartitionID = id
+
+	// Call business
+	tok, err := r.BusiServices.PartitionsSvc.CreateToken(ctx, &input)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GenericPartitionTokenPayload{PartitionToken: tok}, nil
+}
+
+func (r *mutationResolver) RevokePartitionToken(ctx context.Context, input models.RevokeTokenInput) (*model.GenericPartitionTokenPayload, error) {
+	// Transform relay id to id
+	id, err := utils.FromIDRelay(input.ID, mappers.PartitionTokenIDPrefix)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Override data
+	input.ID = id
+
+	// Call business
+	tok, err := r.BusiServices.PartitionsSvc.RevokeToken(ctx, &input)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GenericPartitionTokenPayload{PartitionToken: tok}, nil
+}
+
+func (r *mutationResolver) RotatePartitionToken(ctx context.Context, input models.RotateTokenInput) (*model.GenericPartitionTokenPayload, error) {
+	// Transform relay id to id
+	id, err := utils.FromIDRelay(input.ID, mappers.PartitionTokenIDPrefix)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Override data
+	input.ID = id
+
+	// Call business
+	tok, err := r.BusiServices.PartitionsSvc.RotateToken(ctx, &input)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GenericPartitionTokenPayload{PartitionToken: tok}, nil
+}
+
+func (r *mutationResolver) ReplayDeadLetter(ctx context.Context, input models2.ReplayInput) (*model.GenericDeadLetterPayload, error) {
+	// Transform relay id to id
+	id, err := utils.FromIDRelay(input.ID, mappers.DeadLetterIDPrefix)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Override data
+	input.ID = id
+
+	// Call business
+	dl, err := r.BusiServices.DeadLettersSvc.Replay(ctx, &input)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GenericDeadLetterPayload{DeadLetter: dl}, nil
+}
+
+func (r *queryResolver) Node(ctx context.Context, id string) (model.Node, error) {
+	return r.findNode(ctx, id)
+}
+
+func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]model.Node, error) {
+	return r.findNodes(ctx, ids), nil
+}
+
 func (r *queryResolver) Partitions(ctx context.Context, after *string, before *string, first *int, last *int, sort *models.SortOrder, filter *models.Filter) (*model.PartitionConnection, error) {
 	// Create projection object
 	projection := models.Projection{}
@@ -65,6 +214,12 @@ func (r *queryResolver) Partitions(ctx context.Context, after *string, before *s
 	if err != nil {
 		return nil, err
 	}
+	// Compute counts only when they are asked
+	err = utils.ManageConnectionCountProjection(ctx, pInput)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
 
 	// Get partitions
 	partitions, pOut, err := r.BusiServices.PartitionsSvc.GetAllPaginated(ctx, pInput, sort, filter, &projection)
@@ -136,6 +291,63 @@ func (r *queryResolver) DecisionLog(ctx context.Context, id *string, decisionLog
 	return r.BusiServices.DecisionLogsSvc.FindByIDOrDecisionID(ctx, bid, decisionLogID, &projection)
 }
 
+func (r *queryResolver) DecisionLogs(ctx context.Context, after *string, before *string, first *int, last *int, sort *models1.SortOrder, filter *models1.Filter, search *string, query *string, partitionID *string, partitionName *string) (*model.DecisionLogConnection, error) {
+	// Create projection object
+	projection := models1.Projection{}
+	// Get projection
+	err := utils.ManageConnectionNodeProjection(ctx, &projection)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Ask for id projection
+	projection.ID = true
+
+	// Get page input
+	pInput, err := utils.GetPageInput(after, before, first, last)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Compute counts only when they are asked
+	err = utils.ManageConnectionCountProjection(ctx, pInput)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Transform relay id to business id
+	var bpartitionID *string
+	// Check if partition id exists
+	if partitionID != nil {
+		bid, err := utils.FromIDRelay(*partitionID, mappers.PartitionIDPrefix)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		bpartitionID = &bid
+	}
+
+	// Call business
+	list, pOut, err := r.BusiServices.DecisionLogsSvc.GetAllPaginatedInPartitions(ctx, bpartitionID, partitionName, pInput, sort, filter, &projection, search, query)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Create result
+	var res model.DecisionLogConnection
+	// Manage connection
+	err = utils.MapConnection(&res, list, pOut)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 func (r *queryResolver) Status(ctx context.Context, id string) (*models3.Status, error) {
 	// Create projection object
 	projection := models3.Projection{}
@@ -160,6 +372,130 @@ func (r *queryResolver) Status(ctx context.Context, id string) (*models3.Status,
 	return r.BusiServices.StatusSvc.FindByID(ctx, bid, &projection)
 }
 
+func (r *queryResolver) Statuses(ctx context.Context, after *string, before *string, first *int, last *int, sort *models3.SortOrder, filter *models3.Filter, query *string, partitionID *string, partitionName *string) (*model.StatusConnection, error) {
+	// Create projection object
+	projection := models3.Projection{}
+	// Get projection
+	err := utils.ManageConnectionNodeProjection(ctx, &projection)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Ask for id projection
+	projection.ID = true
+
+	// Get page input
+	pInput, err := utils.GetPageInput(after, before, first, last)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Compute counts only when they are asked
+	err = utils.ManageConnectionCountProjection(ctx, pInput)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Transform relay id to business id
+	var bpartitionID *string
+	// Check if partition id exists
+	if partitionID != nil {
+		bid, err := utils.FromIDRelay(*partitionID, mappers.PartitionIDPrefix)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		bpartitionID = &bid
+	}
+
+	// Call business
+	list, pOut, err := r.BusiServices.StatusSvc.GetAllPaginatedInPartitions(ctx, bpartitionID, partitionName, pInput, sort, filter, &projection, query)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Create result
+	var res model.StatusConnection
+	// Manage connection
+	err = utils.MapConnection(&res, list, pOut)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (r *queryResolver) DeadLetters(ctx context.Context, after *string, before *string, first *int, last *int, sort *models2.SortOrder, filter *models2.Filter) (*model.DeadLetterConnection, error) {
+	// Create projection object
+	projection := models2.Projection{}
+	// Get projection
+	err := utils.ManageConnectionNodeProjection(ctx, &projection)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Ask for id projection
+	projection.ID = true
+
+	// Get page input
+	pInput, err := utils.GetPageInput(after, before, first, last)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Compute counts only when they are asked
+	err = utils.ManageConnectionCountProjection(ctx, pInput)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Call business
+	list, pOut, err := r.BusiServices.DeadLettersSvc.GetAllPaginated(ctx, pInput, sort, filter, &projection)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Create result
+	var res model.DeadLetterConnection
+	// Manage connection
+	err = utils.MapConnection(&res, list, pOut)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (r *queryResolver) DeadLetter(ctx context.Context, id string) (*models2.DeadLetter, error) {
+	// Create projection object
+	projection := models2.Projection{}
+	// Get projection
+	err := utils.ManageSimpleProjection(ctx, &projection)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Ask for id projection
+	projection.ID = true
+
+	// Transform relay id to business id
+	bid, err := utils.FromIDRelay(id, mappers.DeadLetterIDPrefix)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Call business
+	return r.BusiServices.DeadLettersSvc.FindByID(ctx, bid, &projection)
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
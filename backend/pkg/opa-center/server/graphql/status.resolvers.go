@@ -8,6 +8,7 @@ import (
 
 	models1 "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
 	models2 "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/statuses/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/dataloaders"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/generated"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/mappers"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/utils"
@@ -26,17 +27,8 @@ func (r *statusResolver) UpdatedAt(ctx context.Context, obj *models2.Status) (st
 }
 
 func (r *statusResolver) Partition(ctx context.Context, obj *models2.Status) (*models1.Partition, error) {
-	// Create projection object
-	projection := models1.Projection{}
-	// Get projection
-	err := utils.ManageSimpleProjection(ctx, &projection)
-	// Check error
-	if err != nil {
-		return nil, err
-	}
-
-	// Call business
-	return r.BusiServices.PartitionsSvc.FindByID(ctx, obj.PartitionID, &projection)
+	// Load partition with request data loader in order to batch requests on a page
+	return dataloaders.GetLoadersFromContext(ctx).PartitionLoader.Load(obj.PartitionID)
 }
 
 // Status returns generated.StatusResolver implementation.
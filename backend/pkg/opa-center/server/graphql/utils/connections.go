@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
@@ -11,10 +12,13 @@ const edgesFieldName = "Edges"
 const nodeFieldName = "Node"
 const pageInfoFieldName = "PageInfo"
 const cursorFieldName = "Cursor"
+const totalCountFieldName = "TotalCount"
+const estimatedCountFieldName = "EstimatedCount"
 
 // Store supported type of page info.
 var pageInfoSupportedType = reflect.TypeOf(new(PageInfo))
 var cursorSupportedType = reflect.TypeOf("")
+var countSupportedType = reflect.TypeOf(int64(0))
 
 func MapConnection(connectionResult interface{}, list interface{}, pageOut *pagination.PageOutput) error {
 	// Validate that connection result isn't nil
@@ -73,6 +77,16 @@ func MapConnection(connectionResult interface{}, list interface{}, pageOut *pagi
 		return errors.NewInvalidInputError("field PageInfo isn't with the type *PageInfo")
 	}
 
+	// Check that optional count fields are 64 bits integers
+	for _, name := range []string{totalCountFieldName, estimatedCountFieldName} {
+		// Get count type
+		countType, exists := connectionType.FieldByName(name)
+		// Check type if field exists
+		if exists && countType.Type != countSupportedType {
+			return errors.NewInvalidInputError(fmt.Sprintf("field %s must be an int64", name))
+		}
+	}
+
 	// Get edge pointer type
 	edgeTypePtr := edgesType.Type.Elem()
 	// Check that Edge type is a pointer in slice
@@ -120,8 +134,16 @@ func MapConnection(connectionResult interface{}, list interface{}, pageOut *pagi
 
 	// Loop over all items in list
 	for i := 0; i < listLen; i++ {
+		// Check that cursor exists for element
+		if i >= len(pageOut.Cursors) {
+			return errors.NewInvalidInputError("page output must contain a cursor for each element of list")
+		}
 		// Create cursor for element
-		cursor := GetPaginateCursor(i, pageOut.Skip)
+		cursor, err := GetPaginateCursor(pageOut.Cursors[i])
+		// Check error
+		if err != nil {
+			return err
+		}
 
 		// Store start cursor if it is the first element
 		if i == 0 {
@@ -153,5 +175,18 @@ func MapConnection(connectionResult interface{}, list interface{}, pageOut *pagi
 	// Add page info structure in connection
 	topValInd.FieldByName(pageInfoFieldName).Set(reflect.ValueOf(pageInfo))
 
+	// Get total count field
+	totalCountVal := topValInd.FieldByName(totalCountFieldName)
+	// Check if field exists
+	if totalCountVal.IsValid() {
+		totalCountVal.SetInt(pageOut.TotalRecord)
+	}
+	// Get estimated count field
+	estimatedCountVal := topValInd.FieldByName(estimatedCountFieldName)
+	// Check if field exists
+	if estimatedCountVal.IsValid() {
+		estimatedCountVal.SetInt(pageOut.EstimatedRecord)
+	}
+
 	return nil
 }
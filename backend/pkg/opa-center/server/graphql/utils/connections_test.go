@@ -3,6 +3,8 @@
 package utils
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"testing"
 
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
@@ -11,6 +13,12 @@ import (
 
 func TestMapConnection(t *testing.T) {
 	starString := func(s string) *string { return &s }
+	cursors := []*pagination.Cursor{
+		{Values: map[string]json.RawMessage{"id": json.RawMessage(`"1"`)}},
+		{Values: map[string]json.RawMessage{"id": json.RawMessage(`"2"`)}},
+	}
+	cursor1 := base64.StdEncoding.EncodeToString([]byte(`paginate:{"v":{"id":"1"}}`))
+	cursor2 := base64.StdEncoding.EncodeToString([]byte(`paginate:{"v":{"id":"2"}}`))
 	type Person struct{ Name string }
 	type PersonEdge struct {
 		Cursor string
@@ -37,6 +45,12 @@ func TestMapConnection(t *testing.T) {
 		Edges    []*PersonEdge
 		PageInfo *PageInfo
 	}
+	type PersonCountConnection struct {
+		Edges          []*PersonEdge
+		PageInfo       *PageInfo
+		TotalCount     int64
+		EstimatedCount int64
+	}
 	type WrongConnection1 struct {
 	}
 	type WrongConnection2 struct {
@@ -86,6 +100,11 @@ func TestMapConnection(t *testing.T) {
 		Edges    []*WrongEdge5
 		PageInfo *PageInfo
 	}
+	type WrongConnection14 struct {
+		Edges      []*PersonEdge
+		PageInfo   *PageInfo
+		TotalCount string
+	}
 	type args struct {
 		result  interface{}
 		list    interface{}
@@ -219,6 +238,16 @@ func TestMapConnection(t *testing.T) {
 			wantErr:     true,
 			errorString: "field PageInfo not found in connection object",
 		},
+		{
+			name: "TotalCount with wrong type",
+			args: args{
+				result:  &WrongConnection14{},
+				list:    []*Person{{Name: "fake1"}, {Name: "fake2"}},
+				pageOut: &pagination.PageOutput{},
+			},
+			wantErr:     true,
+			errorString: "field TotalCount must be an int64",
+		},
 		{
 			name: "Edge without any needed key",
 			args: args{
@@ -269,6 +298,16 @@ func TestMapConnection(t *testing.T) {
 			wantErr:     true,
 			errorString: "field Node not found in Edge object",
 		},
+		{
+			name: "missing cursors",
+			args: args{
+				result:  &PersonConnection{},
+				list:    []*Person{{Name: "fake1"}, {Name: "fake2"}},
+				pageOut: &pagination.PageOutput{Cursors: cursors[:1]},
+			},
+			wantErr:     true,
+			errorString: "page output must contain a cursor for each element of list",
+		},
 		{
 			name: "empty list array",
 			args: args{
@@ -292,24 +331,24 @@ func TestMapConnection(t *testing.T) {
 			args: args{
 				result:  &PersonConnection{},
 				list:    []*Person{{Name: "fake1"}, {Name: "fake2"}},
-				pageOut: &pagination.PageOutput{},
+				pageOut: &pagination.PageOutput{Cursors: cursors},
 			},
 			expectedResult: &PersonConnection{
 				Edges: []*PersonEdge{
 					{
-						Cursor: "cGFnaW5hdGU6MQ==",
+						Cursor: cursor1,
 						Node:   &Person{Name: "fake1"},
 					},
 					{
-						Cursor: "cGFnaW5hdGU6Mg==",
+						Cursor: cursor2,
 						Node:   &Person{Name: "fake2"},
 					},
 				},
 				PageInfo: &PageInfo{
 					HasNextPage:     false,
 					HasPreviousPage: false,
-					StartCursor:     starString("cGFnaW5hdGU6MQ=="),
-					EndCursor:       starString("cGFnaW5hdGU6Mg=="),
+					StartCursor:     starString(cursor1),
+					EndCursor:       starString(cursor2),
 				},
 			},
 			testResult: true,
@@ -319,25 +358,52 @@ func TestMapConnection(t *testing.T) {
 			args: args{
 				result:  &PersonConnection{},
 				list:    []*Person{{Name: "fake1"}, {Name: "fake2"}},
-				pageOut: &pagination.PageOutput{HasNext: true, HasPrevious: true},
+				pageOut: &pagination.PageOutput{HasNext: true, HasPrevious: true, Cursors: cursors},
 			},
 			expectedResult: &PersonConnection{
 				Edges: []*PersonEdge{
 					{
-						Cursor: "cGFnaW5hdGU6MQ==",
+						Cursor: cursor1,
 						Node:   &Person{Name: "fake1"},
 					},
 					{
-						Cursor: "cGFnaW5hdGU6Mg==",
+						Cursor: cursor2,
 						Node:   &Person{Name: "fake2"},
 					},
 				},
 				PageInfo: &PageInfo{
 					HasNextPage:     true,
 					HasPreviousPage: true,
-					StartCursor:     starString("cGFnaW5hdGU6MQ=="),
-					EndCursor:       starString("cGFnaW5hdGU6Mg=="),
+					StartCursor:     starString(cursor1),
+					EndCursor:       starString(cursor2),
+				},
+			},
+			testResult: true,
+		},
+		{
+			name: "2 elements in list array with counts",
+			args: args{
+				result:  &PersonCountConnection{},
+				list:    []*Person{{Name: "fake1"}, {Name: "fake2"}},
+				pageOut: &pagination.PageOutput{TotalRecord: 2, EstimatedRecord: 3, Cursors: cursors},
+			},
+			expectedResult: &PersonCountConnection{
+				Edges: []*PersonEdge{
+					{
+						Cursor: cursor1,
+						Node:   &Person{Name: "fake1"},
+					},
+					{
+						Cursor: cursor2,
+						Node:   &Person{Name: "fake2"},
+					},
+				},
+				PageInfo: &PageInfo{
+					StartCursor: starString(cursor1),
+					EndCursor:   starString(cursor2),
 				},
+				TotalCount:     2,
+				EstimatedCount: 3,
 			},
 			testResult: true,
 		},
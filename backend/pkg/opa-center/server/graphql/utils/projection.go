@@ -8,11 +8,41 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/database/pagination"
 	"github.com/thoas/go-funk"
 )
 
 const graphqlFieldTagKey = "graphqlfield"
 
+// connectionCountProjection represents count fields that can be asked on a connection.
+type connectionCountProjection struct {
+	TotalCount     bool `graphqlfield:"totalCount"`
+	EstimatedCount bool `graphqlfield:"estimatedCount"`
+}
+
+// ManageConnectionCountProjection will enable counts in page input only when they are asked in connection.
+func ManageConnectionCountProjection(
+	ctx context.Context,
+	pageInput *pagination.PageInput,
+) error {
+	// Create projection object
+	projection := connectionCountProjection{}
+	// Get projection
+	err := ManageSimpleProjection(ctx, &projection)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Count only if total count is asked
+	pageInput.WithoutCount = !projection.TotalCount
+	// Estimate count only if estimated count is asked
+	pageInput.WithEstimatedCount = projection.EstimatedCount
+
+	// Default
+	return nil
+}
+
 func ManageConnectionNodeProjection(
 	ctx context.Context,
 	projectionOut interface{},
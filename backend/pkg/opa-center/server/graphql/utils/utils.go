@@ -2,8 +2,8 @@ package utils
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
@@ -20,33 +20,53 @@ func ToIDRelay(prefix, id string) string {
 }
 
 func FromIDRelay(relayID, prefix string) (string, error) {
+	// Decode relay id
+	idPrefix, id, err := DecodeIDRelay(relayID)
+	// Check error
+	if err != nil {
+		return "", err
+	}
+	// Check that first item of split is a good
+	if idPrefix != prefix {
+		return "", errors.NewInvalidInputError("invalid relay prefix")
+	}
+
+	return id, nil
+}
+
+// DecodeIDRelay will decode a relay id and return its prefix and business id.
+func DecodeIDRelay(relayID string) (string, string, error) {
 	// Base64 decode
 	idBb, err := base64.StdEncoding.DecodeString(relayID)
 	// Check error
 	if err != nil {
-		return "", errors.NewInvalidInputErrorWithError(err)
+		return "", "", errors.NewInvalidInputErrorWithError(err)
 	}
 
 	idContent := string(idBb)
 	// Split
 	sp := strings.Split(idContent, ":")
 	if len(sp) != relayIDSplitSize {
-		return "", errors.NewInvalidInputError("format error on relay token")
-	}
-	// Check that first item of split is a good
-	if sp[0] != prefix {
-		return "", errors.NewInvalidInputError("invalid relay prefix")
+		return "", "", errors.NewInvalidInputError("format error on relay token")
 	}
 
-	return sp[1], nil
+	return sp[0], sp[1], nil
 }
 
 func FormatTime(ti time.Time) string {
 	return ti.Format(time.RFC3339)
 }
 
-func GetPaginateCursor(tableIndex, skip int) string {
-	return ToIDRelay(paginationIDPrefix, fmt.Sprintf("%d", tableIndex+skip+1))
+// GetPaginateCursor will encode a pagination cursor.
+func GetPaginateCursor(cursor *pagination.Cursor) (string, error) {
+	// Marshal cursor
+	bb, err := json.Marshal(cursor)
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", paginationIDPrefix, string(bb)))), nil
 }
 
 func GetPageInfo(startCursor, endCursor string, p *pagination.PageOutput) *PageInfo {
@@ -102,39 +122,34 @@ func GetPageInput(after *string, before *string, first *int, last *int) (*pagina
 	}
 
 	// Create parginator input
-	var res pagination.PageInput
+	// Count isn't needed to compute page info
+	res := pagination.PageInput{WithoutCount: true}
 
 	// Before case
 	if before != nil && *before != "" {
-		i, err := parsePaginateCursor(*before)
+		c, err := parsePaginateCursor(*before)
 		// Check error
 		if err != nil {
 			return nil, err
 		}
 
-		res.Skip = i - *last - 1
+		res.Before = c
 		res.Limit = *last
-
-		// Check if skip is positive
-		if res.Skip < 0 {
-			res.Skip = 0
-		}
 	}
 
 	// After case
 	if after != nil && *after != "" {
-		i, err := parsePaginateCursor(*after)
+		c, err := parsePaginateCursor(*after)
 		// Check error
 		if err != nil {
 			return nil, err
 		}
 
-		res.Skip = i
-		res.Limit = *first
+		res.After = c
 	}
 
-	// First not null and after is
-	if (after == nil || *after == "") && first != nil {
+	// First case
+	if first != nil {
 		res.Limit = *first
 	}
 
@@ -151,22 +166,33 @@ func GetPageInput(after *string, before *string, first *int, last *int) (*pagina
 	return &res, nil
 }
 
-func parsePaginateCursor(cursorB64 string) (int, error) {
-	val, err := FromIDRelay(cursorB64, paginationIDPrefix)
+func parsePaginateCursor(cursorB64 string) (*pagination.Cursor, error) {
+	// Base64 decode
+	bb, err := base64.StdEncoding.DecodeString(cursorB64)
 	// Check error
 	if err != nil {
-		return 0, err
+		return nil, errors.NewInvalidInputErrorWithError(err)
 	}
-	// Parse cursor int
-	res, err := strconv.Atoi(val)
-	// Check error
-	if err != nil {
-		return 0, err
+
+	// Split prefix and content
+	sp := strings.SplitN(string(bb), ":", relayIDSplitSize)
+	// Check format
+	if len(sp) != relayIDSplitSize {
+		return nil, errors.NewInvalidInputError("format error on relay token")
 	}
-	// Check if cursor is positive
-	if res < 0 {
-		return 0, errors.NewInvalidInputError("cursor pagination must be positive")
+	// Check prefix
+	if sp[0] != paginationIDPrefix {
+		return nil, errors.NewInvalidInputError("invalid relay prefix")
 	}
 
-	return res, nil
+	// Parse cursor
+	var res pagination.Cursor
+	// Unmarshal
+	err = json.Unmarshal([]byte(sp[1]), &res)
+	// Check error
+	if err != nil || len(res.Values) == 0 {
+		return nil, errors.NewInvalidInputError("invalid cursor")
+	}
+
+	return &res, nil
 }
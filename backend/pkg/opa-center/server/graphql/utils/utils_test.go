@@ -4,6 +4,7 @@ package utils
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -43,8 +44,55 @@ func Test_ToIDRelay(t *testing.T) {
 	}
 }
 
+func Test_DecodeIDRelay(t *testing.T) {
+	tests := []struct {
+		name        string
+		relayID     string
+		wantPrefix  string
+		wantID      string
+		wantErr     bool
+		errorString string
+	}{
+		{
+			name:       "valid",
+			relayID:    base64.StdEncoding.EncodeToString([]byte("prefix:id")),
+			wantPrefix: "prefix",
+			wantID:     "id",
+		},
+		{
+			name:        "invalid base64",
+			relayID:     "fake:fake",
+			wantErr:     true,
+			errorString: "illegal base64 data at input byte 4",
+		},
+		{
+			name:        "invalid format",
+			relayID:     base64.StdEncoding.EncodeToString([]byte("fake")),
+			wantErr:     true,
+			errorString: "format error on relay token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPrefix, gotID, err := DecodeIDRelay(tt.relayID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DecodeIDRelay() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errorString {
+				t.Errorf("DecodeIDRelay() error = %v, wantErr %v", err, tt.errorString)
+				return
+			}
+			if gotPrefix != tt.wantPrefix || gotID != tt.wantID {
+				t.Errorf("DecodeIDRelay() = %v, %v, want %v, %v", gotPrefix, gotID, tt.wantPrefix, tt.wantID)
+			}
+		})
+	}
+}
+
 func TestGetPageInput(t *testing.T) {
 	toStarString := func(s string) *string { return &s }
+	cursor := &pagination.Cursor{Values: map[string]json.RawMessage{"id": json.RawMessage(`"1"`)}}
+	cursorB64 := base64.StdEncoding.EncodeToString([]byte(`paginate:{"v":{"id":"1"}}`))
 	toStarInt := func(i int) *int { return &i }
 	type args struct {
 		after  *string
@@ -64,8 +112,8 @@ func TestGetPageInput(t *testing.T) {
 			args:    args{},
 			wantErr: false,
 			want: &pagination.PageInput{
-				Limit: 10,
-				Skip:  0,
+				Limit:        10,
+				WithoutCount: true,
 			},
 		},
 		{
@@ -146,63 +194,52 @@ func TestGetPageInput(t *testing.T) {
 			wantErr:     true,
 			errorString: "first must be > 0",
 		},
-		{
-			name: "before case with negative substract",
-			args: args{
-				before: toStarString(base64.StdEncoding.EncodeToString([]byte("paginate:1"))),
-				last:   toStarInt(2),
-			},
-			want: &pagination.PageInput{
-				Skip:  0,
-				Limit: 2,
-			},
-		},
 		{
 			name: "before case",
 			args: args{
-				before: toStarString(base64.StdEncoding.EncodeToString([]byte("paginate:10"))),
+				before: toStarString(cursorB64),
 				last:   toStarInt(2),
 			},
 			want: &pagination.PageInput{
-				Skip:  7,
-				Limit: 2,
+				Before:       cursor,
+				Limit:        2,
+				WithoutCount: true,
 			},
 		},
 		{
 			name: "before case with too big limit",
 			args: args{
-				before: toStarString(base64.StdEncoding.EncodeToString([]byte("paginate:10"))),
+				before: toStarString(cursorB64),
 				last:   toStarInt(200),
 			},
 			wantErr:     true,
 			errorString: "first or last is too big, maximum is 50",
 		},
 		{
-			name: "after case",
+			name: "before case with invalid cursor",
 			args: args{
-				after: toStarString(base64.StdEncoding.EncodeToString([]byte("paginate:10"))),
-				first: toStarInt(2),
-			},
-			want: &pagination.PageInput{
-				Skip:  10,
-				Limit: 2,
+				before: toStarString(base64.StdEncoding.EncodeToString([]byte("paginate:10"))),
+				last:   toStarInt(2),
 			},
+			wantErr:     true,
+			errorString: "invalid cursor",
 		},
 		{
-			name: "after case 2",
+			name: "after case",
 			args: args{
-				after: toStarString(base64.StdEncoding.EncodeToString([]byte("paginate:1"))),
+				after: toStarString(cursorB64),
 				first: toStarInt(2),
 			},
 			want: &pagination.PageInput{
-				Skip:  1,
-				Limit: 2,
+				After:        cursor,
+				Limit:        2,
+				WithoutCount: true,
 			},
 		},
 		{
 			name: "after case with too big limit",
 			args: args{
-				after: toStarString(base64.StdEncoding.EncodeToString([]byte("paginate:10"))),
+				after: toStarString(cursorB64),
 				first: toStarInt(200),
 			},
 			wantErr:     true,
@@ -214,8 +251,8 @@ func TestGetPageInput(t *testing.T) {
 				first: toStarInt(2),
 			},
 			want: &pagination.PageInput{
-				Skip:  0,
-				Limit: 2,
+				Limit:        2,
+				WithoutCount: true,
 			},
 		},
 		{
@@ -225,8 +262,8 @@ func TestGetPageInput(t *testing.T) {
 				first: toStarInt(2),
 			},
 			want: &pagination.PageInput{
-				Skip:  0,
-				Limit: 2,
+				Limit:        2,
+				WithoutCount: true,
 			},
 		},
 		{
@@ -263,7 +300,7 @@ func Test_parsePaginateCursor(t *testing.T) {
 	tests := []struct {
 		name        string
 		args        args
-		want        int
+		want        *pagination.Cursor
 		wantErr     bool
 		errorString string
 	}{
@@ -278,7 +315,7 @@ func Test_parsePaginateCursor(t *testing.T) {
 		{
 			name: "invalid format",
 			args: args{
-				cursorB64: "fake",
+				cursorB64: base64.StdEncoding.EncodeToString([]byte("fake")),
 			},
 			wantErr:     true,
 			errorString: "format error on relay token",
@@ -286,33 +323,33 @@ func Test_parsePaginateCursor(t *testing.T) {
 		{
 			name: "invalid prefix",
 			args: args{
-				cursorB64: base64.StdEncoding.EncodeToString([]byte("fake:1")),
+				cursorB64: base64.StdEncoding.EncodeToString([]byte(`fake:{"v":{"id":"1"}}`)),
 			},
 			wantErr:     true,
 			errorString: "invalid relay prefix",
 		},
 		{
-			name: "invalid number",
+			name: "invalid json",
 			args: args{
 				cursorB64: base64.StdEncoding.EncodeToString([]byte("paginate:fake")),
 			},
 			wantErr:     true,
-			errorString: "strconv.Atoi: parsing \"fake\": invalid syntax",
+			errorString: "invalid cursor",
 		},
 		{
-			name: "cursor must be positive",
+			name: "empty values",
 			args: args{
-				cursorB64: base64.StdEncoding.EncodeToString([]byte("paginate:-1")),
+				cursorB64: base64.StdEncoding.EncodeToString([]byte(`paginate:{"v":{}}`)),
 			},
 			wantErr:     true,
-			errorString: "cursor pagination must be positive",
+			errorString: "invalid cursor",
 		},
 		{
 			name: "valid",
 			args: args{
-				cursorB64: base64.StdEncoding.EncodeToString([]byte("paginate:1")),
+				cursorB64: base64.StdEncoding.EncodeToString([]byte(`paginate:{"v":{"id":"1"}}`)),
 			},
-			want: 1,
+			want: &pagination.Cursor{Values: map[string]json.RawMessage{"id": json.RawMessage(`"1"`)}},
 		},
 	}
 	for _, tt := range tests {
@@ -325,7 +362,7 @@ func Test_parsePaginateCursor(t *testing.T) {
 				t.Errorf("parsePaginateCursor() error = %v, wantErr %v", err, tt.errorString)
 				return
 			}
-			if got != tt.want {
+			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("parsePaginateCursor() = %v, want %v", got, tt.want)
 			}
 		})
@@ -333,51 +370,33 @@ func Test_parsePaginateCursor(t *testing.T) {
 }
 
 func Test_GetPaginateCursor(t *testing.T) {
-	type args struct {
-		index int
-		skip  int
-	}
 	tests := []struct {
-		name string
-		args args
-		want string
+		name   string
+		cursor *pagination.Cursor
+		want   string
 	}{
 		{
-			name: "empty",
-			args: args{
-				index: 0,
-				skip:  0,
-			},
-			want: base64.StdEncoding.EncodeToString([]byte("paginate:1")),
+			name:   "empty",
+			cursor: &pagination.Cursor{},
+			want:   base64.StdEncoding.EncodeToString([]byte(`paginate:{"v":null}`)),
 		},
 		{
-			name: "skip",
-			args: args{
-				index: 0,
-				skip:  5,
-			},
-			want: base64.StdEncoding.EncodeToString([]byte("paginate:6")),
-		},
-		{
-			name: "index",
-			args: args{
-				index: 5,
-				skip:  0,
-			},
-			want: base64.StdEncoding.EncodeToString([]byte("paginate:6")),
-		},
-		{
-			name: "index and skip",
-			args: args{
-				index: 5,
-				skip:  5,
-			},
-			want: base64.StdEncoding.EncodeToString([]byte("paginate:11")),
+			name: "values",
+			cursor: &pagination.Cursor{Values: map[string]json.RawMessage{
+				"id":         json.RawMessage(`"1"`),
+				"created_at": json.RawMessage(`"2021-01-01T00:00:00Z"`),
+			}},
+			want: base64.StdEncoding.EncodeToString([]byte(`paginate:{"v":{"created_at":"2021-01-01T00:00:00Z","id":"1"}}`)),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := GetPaginateCursor(tt.args.index, tt.args.skip); got != tt.want {
+			got, err := GetPaginateCursor(tt.cursor)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if got != tt.want {
 				t.Errorf("GetPaginateCursor() = %v, want %v", got, tt.want)
 			}
 		})
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 	"time"
@@ -96,10 +97,12 @@ func (svr *InternalServer) generateInternalRouter() (http.Handler, error) {
 }
 
 func (svr *InternalServer) Listen() error {
-	svr.logger.Infof("Internal server listening on %s", svr.server.Addr)
-	err := svr.server.ListenAndServe()
+	return listenAndServe(svr.server, svr.logger, "Internal server")
+}
 
-	return err
+// Shutdown will stop accepting new requests and wait for active requests until context is done.
+func (svr *InternalServer) Shutdown(ctx context.Context) error {
+	return svr.server.Shutdown(ctx)
 }
 
 func (svr *InternalServer) GenerateServer() error {
@@ -116,6 +119,14 @@ func (svr *InternalServer) GenerateServer() error {
 		Addr:    addr,
 		Handler: r,
 	}
+	// Setup TLS
+	err = setupServerTLS(server, svr.cfgManager, svr.logger, "Internal server", func(cfg *config.Config) *config.ServerConfig {
+		return cfg.InternalServer
+	})
+	// Check error
+	if err != nil {
+		return err
+	}
 	// Store server
 	svr.server = server
 
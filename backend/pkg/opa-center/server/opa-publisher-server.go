@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 
@@ -10,8 +11,10 @@ import (
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/authx/authentication"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/ingestion"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/metrics"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/ratelimit"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/middlewares"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/rest"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/tracing"
@@ -24,13 +27,16 @@ type OPAPublisherServer struct {
 	tracingSvc        tracing.Service
 	busiServices      *business.Services
 	authenticationSvc authentication.Client
+	ingestionCl       ingestion.Client
+	limitsCl          ratelimit.Client
 	server            *http.Server
 }
 
 func NewOPAPublisherServer(
 	logger log.Logger, cfgManager config.Manager, metricsCl metrics.Client,
 	tracingSvc tracing.Service, busiServices *business.Services,
-	authenticationSvc authentication.Client,
+	authenticationSvc authentication.Client, ingestionCl ingestion.Client,
+	limitsCl ratelimit.Client,
 ) *OPAPublisherServer {
 	return &OPAPublisherServer{
 		logger:            logger,
@@ -39,6 +45,8 @@ func NewOPAPublisherServer(
 		tracingSvc:        tracingSvc,
 		busiServices:      busiServices,
 		authenticationSvc: authenticationSvc,
+		ingestionCl:       ingestionCl,
+		limitsCl:          limitsCl,
 	}
 }
 
@@ -55,6 +63,15 @@ func (svr *OPAPublisherServer) GenerateServer() error {
 		Handler: r,
 	}
 
+	// Setup TLS
+	err := setupServerTLS(server, svr.cfgManager, svr.logger, "OPA Publisher Server", func(cfg *config.Config) *config.ServerConfig {
+		return cfg.OPAPublisherServer
+	})
+	// Check error
+	if err != nil {
+		return err
+	}
+
 	// Prepare for configuration onChange
 	svr.cfgManager.AddOnChangeHook(func() {
 		// Generate router
@@ -104,15 +121,17 @@ func (svr *OPAPublisherServer) generateRouter() http.Handler {
 	// }
 
 	// Add REST endpoints
-	rest.AddDecisionLogsEndpoints(router, svr.busiServices)
-	rest.AddStatusEndpoints(router, svr.busiServices)
+	rest.AddDecisionLogsEndpoints(router, svr.busiServices, svr.ingestionCl, svr.limitsCl, svr.metricsCl)
+	rest.AddStatusEndpoints(router, svr.busiServices, svr.ingestionCl, svr.limitsCl, svr.metricsCl)
 
 	return router
 }
 
 func (svr *OPAPublisherServer) Listen() error {
-	svr.logger.Infof("OPA Publisher Server listening on %s", svr.server.Addr)
-	err := svr.server.ListenAndServe()
+	return listenAndServe(svr.server, svr.logger, "OPA Publisher Server")
+}
 
-	return err
+// Shutdown will stop accepting new requests and wait for active requests until context is done.
+func (svr *OPAPublisherServer) Shutdown(ctx context.Context) error {
+	return svr.server.Shutdown(ctx)
 }
@@ -0,0 +1,17 @@
+package rest
+
+import (
+	"crypto/x509"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getClientCertificate will return the verified client certificate of request if it exists.
+func getClientCertificate(c *gin.Context) *x509.Certificate {
+	// Check if request contains a client certificate verified against client CA
+	if c.Request.TLS == nil || len(c.Request.TLS.VerifiedChains) == 0 || len(c.Request.TLS.VerifiedChains[0]) == 0 {
+		return nil
+	}
+
+	return c.Request.TLS.VerifiedChains[0][0]
+}
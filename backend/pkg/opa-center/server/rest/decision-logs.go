@@ -1,17 +1,22 @@
 package rest
 
 import (
-	"encoding/json"
-	"io/ioutil"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/utils"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/ingestion"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/metrics"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/ratelimit"
 )
 
-func AddDecisionLogsEndpoints(router gin.IRouter, busiServices *business.Services) {
+func AddDecisionLogsEndpoints(router gin.IRouter, busiServices *business.Services,
+	ingestionCl ingestion.Client,
+	limitsCl ratelimit.Client,
+	metricsCl metrics.Client,
+) {
 	router.POST("/api/logs/:partitionid", func(c *gin.Context) {
 		// Get logger from request
 		logger := log.GetLoggerFromGin(c)
@@ -19,7 +24,12 @@ func AddDecisionLogsEndpoints(router gin.IRouter, busiServices *business.Service
 		partitionID := c.Param("partitionid")
 
 		// Check if it is authenticated
-		err := busiServices.PartitionsSvc.CheckAuthenticated(c.Request.Context(), partitionID, c.GetHeader("Authorization"))
+		partition, err := busiServices.PartitionsSvc.CheckAuthenticated(
+			c.Request.Context(),
+			partitionID,
+			c.GetHeader("Authorization"),
+			getClientCertificate(c),
+		)
 		// Check error
 		if err != nil {
 			logger.Error(err)
@@ -28,39 +38,45 @@ func AddDecisionLogsEndpoints(router gin.IRouter, busiServices *business.Service
 			return
 		}
 
-		// Read all input
-		bb, err := ioutil.ReadAll(c.Request.Body)
+		// Read input within partition limits
+		bb, err := readPayload(c, partition, limitsCl, metricsCl)
 		// Check error
 		if err != nil {
 			logger.Error(err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err})
+			utils.AnswerWithError(c, err)
 
 			return
 		}
 
-		// Create data for input parsing
-		var mm []map[string]interface{}
-
-		err = json.Unmarshal(bb, &mm)
+		// Check decisions limit
+		err = checkDecisionsLimit(bb, partition, limitsCl, metricsCl)
 		// Check error
 		if err != nil {
 			logger.Error(err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": err})
+			utils.AnswerWithError(c, err)
 
 			return
 		}
 
-		// Call service
-		err = busiServices.DecisionLogsSvc.UnsecureCreate(partitionID, mm)
+		// Ingest payload
+		res, err := ingestionCl.IngestDecisionLogs(logger, partitionID, bb)
 		// Check error
 		if err != nil {
 			logger.Error(err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err})
+			utils.AnswerWithError(c, err)
+
+			return
+		}
+
+		// Check if payload have been queued
+		if res == nil {
+			c.JSON(http.StatusOK, gin.H{"answer": "ok"})
 
 			return
 		}
 
-		// Answer ok
-		c.JSON(http.StatusOK, gin.H{"answer": "ok"})
+		// Answer ok with details
+		// Status code must be 200 otherwise OPA will retry the whole payload
+		c.JSON(http.StatusOK, gin.H{"answer": "ok", "accepted": res.Accepted, "rejected": res.Rejected})
 	})
 }
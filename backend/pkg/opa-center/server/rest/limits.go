@@ -0,0 +1,84 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/gin-gonic/gin"
+	pmodels "github.com/oxyno-zeta/opa-center/pkg/opa-center/business/partitions/models"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/errors"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/metrics"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/ratelimit"
+)
+
+const (
+	requestsLimitName    = "requests"
+	decisionsLimitName   = "decisions"
+	maxBodySizeLimitName = "max_body_size"
+)
+
+// readPayload will check request rate and read body within the maximum body size of partition.
+func readPayload(
+	c *gin.Context,
+	partition *pmodels.Partition,
+	limitsCl ratelimit.Client,
+	metricsCl metrics.Client,
+) ([]byte, error) {
+	// Check request rate
+	if !limitsCl.AllowRequest(partition) {
+		metricsCl.IncIngestionLimitReached(partition.Name, requestsLimitName)
+
+		return nil, errors.NewTooManyRequestsError("too many requests for partition")
+	}
+
+	// Get maximum body size
+	maxBodySize := limitsCl.GetMaxBodySize(partition)
+	// Check if there isn't any limit
+	if maxBodySize == 0 {
+		return ioutil.ReadAll(c.Request.Body)
+	}
+
+	// Read one more byte than allowed in order to detect too large bodies
+	bb, err := ioutil.ReadAll(io.LimitReader(c.Request.Body, maxBodySize+1))
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check size
+	if int64(len(bb)) > maxBodySize {
+		metricsCl.IncIngestionLimitReached(partition.Name, maxBodySizeLimitName)
+
+		return nil, errors.NewPayloadTooLargeError(fmt.Sprintf("payload is larger than %d bytes", maxBodySize))
+	}
+
+	return bb, nil
+}
+
+// checkDecisionsLimit will check decisions rate of partition.
+func checkDecisionsLimit(
+	bb []byte,
+	partition *pmodels.Partition,
+	limitsCl ratelimit.Client,
+	metricsCl metrics.Client,
+) error {
+	// Count decisions without parsing them
+	var list []json.RawMessage
+	// Parse
+	err := json.Unmarshal(bb, &list)
+	// Check error
+	if err != nil {
+		// Ignore error, invalid payload will be rejected by ingestion
+		return nil
+	}
+
+	// Check decisions rate
+	if !limitsCl.AllowDecisions(partition, len(list)) {
+		metricsCl.IncIngestionLimitReached(partition.Name, decisionsLimitName)
+
+		return errors.NewTooManyRequestsError("too many decisions for partition")
+	}
+
+	return nil
+}
@@ -1,17 +1,22 @@
 package rest
 
 import (
-	"encoding/json"
-	"io/ioutil"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/business"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/common/utils"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/ingestion"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/metrics"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/ratelimit"
 )
 
-func AddStatusEndpoints(router gin.IRouter, busiServices *business.Services) {
+func AddStatusEndpoints(router gin.IRouter, busiServices *business.Services,
+	ingestionCl ingestion.Client,
+	limitsCl ratelimit.Client,
+	metricsCl metrics.Client,
+) {
 	router.POST("/api/status/:partitionid", func(c *gin.Context) {
 		// Get logger from request
 		logger := log.GetLoggerFromGin(c)
@@ -19,7 +24,12 @@ func AddStatusEndpoints(router gin.IRouter, busiServices *business.Services) {
 		partitionID := c.Param("partitionid")
 
 		// Check if it is authenticated
-		err := busiServices.PartitionsSvc.CheckAuthenticated(c.Request.Context(), partitionID, c.GetHeader("Authorization"))
+		partition, err := busiServices.PartitionsSvc.CheckAuthenticated(
+			c.Request.Context(),
+			partitionID,
+			c.GetHeader("Authorization"),
+			getClientCertificate(c),
+		)
 		// Check error
 		if err != nil {
 			logger.Error(err)
@@ -28,34 +38,22 @@ func AddStatusEndpoints(router gin.IRouter, busiServices *business.Services) {
 			return
 		}
 
-		// Read all input
-		bb, err := ioutil.ReadAll(c.Request.Body)
+		// Read input within partition limits
+		bb, err := readPayload(c, partition, limitsCl, metricsCl)
 		// Check error
 		if err != nil {
 			logger.Error(err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err})
-
-			return
-		}
-
-		// Create data for input parsing
-		var mm map[string]interface{}
-
-		err = json.Unmarshal(bb, &mm)
-		// Check error
-		if err != nil {
-			logger.Error(err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": err})
+			utils.AnswerWithError(c, err)
 
 			return
 		}
 
-		// Call service
-		err = busiServices.StatusSvc.UnsecureCreate(partitionID, mm)
+		// Ingest payload
+		err = ingestionCl.IngestStatus(logger, partitionID, bb)
 		// Check error
 		if err != nil {
 			logger.Error(err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err})
+			utils.AnswerWithError(c, err)
 
 			return
 		}
@@ -23,6 +23,7 @@ import (
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/metrics"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/dataloaders"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/graphql/generated"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/server/middlewares"
 	"github.com/oxyno-zeta/opa-center/pkg/opa-center/tracing"
@@ -72,6 +73,15 @@ func (svr *Server) GenerateServer() error {
 		Handler: r,
 	}
 
+	// Setup TLS
+	err = setupServerTLS(server, svr.cfgManager, svr.logger, "Server", func(cfg *config.Config) *config.ServerConfig {
+		return cfg.Server
+	})
+	// Check error
+	if err != nil {
+		return err
+	}
+
 	// Prepare for configuration onChange
 	svr.cfgManager.AddOnChangeHook(func() {
 		// Generate router
@@ -159,10 +169,12 @@ func (svr *Server) generateRouter() (http.Handler, error) {
 }
 
 func (svr *Server) Listen() error {
-	svr.logger.Infof("Server listening on %s", svr.server.Addr)
-	err := svr.server.ListenAndServe()
+	return listenAndServe(svr.server, svr.logger, "Server")
+}
 
-	return err
+// Shutdown will stop accepting new requests and wait for active requests until context is done.
+func (svr *Server) Shutdown(ctx context.Context) error {
+	return svr.server.Shutdown(ctx)
 }
 
 // Defining the Graphql handler.
@@ -198,7 +210,12 @@ func (svr *Server) graphqlHandler(busiServices *business.Services) gin.HandlerFu
 	h.Use(svr.metricsCl.GraphqlMiddleware())
 
 	return func(c *gin.Context) {
-		h.ServeHTTP(c.Writer, c.Request)
+		// Memoize authorization results during request
+		ctx := authorization.SetMemoizationToContext(c.Request.Context())
+		// Add request scoped data loaders
+		ctx = dataloaders.SetLoadersToContext(ctx, dataloaders.NewLoaders(ctx, busiServices))
+
+		h.ServeHTTP(c.Writer, c.Request.WithContext(ctx))
 	}
 }
 
@@ -0,0 +1,245 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/log"
+)
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// setupServerTLS will enable TLS on server when it is configured and reload certificates on configuration changes.
+// Enabling or disabling TLS requires a restart.
+func setupServerTLS(
+	server *http.Server,
+	cfgManager config.Manager,
+	logger log.Logger,
+	serverName string,
+	getServerConfig func(cfg *config.Config) *config.ServerConfig,
+) error {
+	// Get TLS configuration
+	tlsCfg := getServerConfig(cfgManager.GetConfig()).TLS
+	// Check if TLS is enabled
+	if tlsCfg == nil {
+		return nil
+	}
+
+	// Create reloader
+	reloader, err := newTLSConfigReloader(tlsCfg)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Set TLS configuration
+	server.TLSConfig = reloader.TLSConfig()
+
+	// Prepare for configuration onChange (configuration or certificate files)
+	cfgManager.AddOnChangeHook(func() {
+		// Get new TLS configuration
+		tlsCfg := getServerConfig(cfgManager.GetConfig()).TLS
+		// Check if TLS have been disabled
+		if tlsCfg == nil {
+			logger.Warnf("%s TLS cannot be disabled without restart", serverName)
+
+			return
+		}
+
+		// Reload
+		err := reloader.Reload(tlsCfg)
+		// Check error
+		if err != nil {
+			// Previous certificates are kept
+			logger.Error(err)
+
+			return
+		}
+
+		logger.Infof("%s TLS configuration reloaded", serverName)
+	})
+
+	return nil
+}
+
+// listenAndServe will start server with TLS when it is enabled.
+func listenAndServe(server *http.Server, logger log.Logger, serverName string) error {
+	// Check if TLS is enabled
+	if server.TLSConfig != nil {
+		logger.Infof("%s listening with TLS on %s", serverName, server.Addr)
+		// Certificates are already loaded in TLS configuration
+		return ignoreServerClosed(server.ListenAndServeTLS("", ""))
+	}
+
+	logger.Infof("%s listening on %s", serverName, server.Addr)
+
+	return ignoreServerClosed(server.ListenAndServe())
+}
+
+// ignoreServerClosed will ignore error returned when server is stopped by a shutdown.
+func ignoreServerClosed(err error) error {
+	// Check if server have been stopped
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
+
+// tlsConfigReloader will serve the last loaded TLS configuration
+// in order to reload certificates without restarting servers.
+type tlsConfigReloader struct {
+	current *tls.Config
+	mutex   sync.RWMutex
+}
+
+func newTLSConfigReloader(cfg *config.ServerTLSConfig) (*tlsConfigReloader, error) {
+	// Create reloader
+	res := &tlsConfigReloader{}
+	// Load configuration
+	err := res.Reload(cfg)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Reload will load certificates and configuration again.
+// Current configuration is kept when an error occurs.
+func (r *tlsConfigReloader) Reload(cfg *config.ServerTLSConfig) error {
+	// Generate TLS configuration
+	tlsCfg, err := generateTLSConfig(cfg)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Store configuration
+	r.mutex.Lock()
+	r.current = tlsCfg
+	r.mutex.Unlock()
+
+	return nil
+}
+
+// TLSConfig will return a server TLS configuration using the last loaded configuration for each connection.
+func (r *tlsConfigReloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return &r.get().Certificates[0], nil
+		},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.get(), nil
+		},
+	}
+}
+
+func (r *tlsConfigReloader) get() *tls.Config {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.current
+}
+
+// generateTLSConfig will generate a TLS configuration with server certificate and optional client CA.
+func generateTLSConfig(cfg *config.ServerTLSConfig) (*tls.Config, error) {
+	// Load server certificate
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Get minimum version
+	minVersion, ok := tlsVersions[cfg.MinVersion]
+	// Check if version exists
+	if !ok {
+		return nil, fmt.Errorf("unsupported TLS version %s", cfg.MinVersion)
+	}
+
+	// Parse cipher suites
+	cipherSuites, err := parseCipherSuites(cfg.CipherSuites)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Create result
+	res := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   minVersion,
+		CipherSuites: cipherSuites,
+		// Configuration is returned per connection so HTTP/2 must be declared here
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+
+	// Check if client certificates must be verified
+	if cfg.ClientCAFile != "" {
+		// Read client CA bundle
+		pem, err := ioutil.ReadFile(cfg.ClientCAFile)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		// Create pool
+		pool := x509.NewCertPool()
+		// Add certificates
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in client CA file %s", cfg.ClientCAFile)
+		}
+
+		res.ClientCAs = pool
+		// Client certificates are optional by default in order to allow other authentication methods
+		res.ClientAuth = tls.VerifyClientCertIfGiven
+		// Check if client certificate is required
+		if cfg.RequireClientCert {
+			res.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+
+	return res, nil
+}
+
+// parseCipherSuites will transform cipher suite names into identifiers.
+// An empty list means Go default cipher suites.
+func parseCipherSuites(names []string) ([]uint16, error) {
+	// Check if list is empty
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	// Index all known cipher suites
+	known := map[string]uint16{}
+	for _, cs := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		known[cs.Name] = cs.ID
+	}
+
+	// Create result
+	res := make([]uint16, 0, len(names))
+	// Loop over names
+	for _, n := range names {
+		id, ok := known[n]
+		// Check if cipher suite exists
+		if !ok {
+			return nil, fmt.Errorf("unknown cipher suite %s", n)
+		}
+
+		res = append(res, id)
+	}
+
+	return res, nil
+}
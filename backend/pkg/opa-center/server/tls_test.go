@@ -0,0 +1,135 @@
+//+build unit
+
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/oxyno-zeta/opa-center/pkg/opa-center/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeSelfSignedCertificate will write a self signed certificate and its key in directory.
+func writeSelfSignedCertificate(t *testing.T, dir, cn string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	tpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	assert.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+
+	return certFile, keyFile
+}
+
+func Test_parseCipherSuites(t *testing.T) {
+	tests := []struct {
+		name    string
+		names   []string
+		want    []uint16
+		wantErr bool
+	}{
+		{
+			name:  "empty",
+			names: nil,
+			want:  nil,
+		},
+		{
+			name:  "valid",
+			names: []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+			want:  []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384},
+		},
+		{
+			name:    "unknown",
+			names:   []string{"FAKE"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCipherSuites(tt.names)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseCipherSuites() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_tlsConfigReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "opa-center-tls")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	certFile, keyFile := writeSelfSignedCertificate(t, dir, "first")
+	cfg := &config.ServerTLSConfig{CertFile: certFile, KeyFile: keyFile, MinVersion: "1.2"}
+
+	r, err := newTLSConfigReloader(cfg)
+	assert.NoError(t, err)
+
+	tlsCfg := r.TLSConfig()
+	cert, err := tlsCfg.GetCertificate(nil)
+	assert.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "first", leaf.Subject.CommonName)
+
+	clientCfg, err := tlsCfg.GetConfigForClient(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS12), clientCfg.MinVersion)
+	assert.Equal(t, tls.NoClientCert, clientCfg.ClientAuth)
+
+	// Rotate certificate
+	writeSelfSignedCertificate(t, dir, "second")
+	assert.NoError(t, r.Reload(cfg))
+
+	cert, err = tlsCfg.GetCertificate(nil)
+	assert.NoError(t, err)
+	leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "second", leaf.Subject.CommonName)
+
+	// Invalid configuration must keep previous certificate
+	assert.Error(t, r.Reload(&config.ServerTLSConfig{CertFile: "fake", KeyFile: "fake", MinVersion: "1.2"}))
+
+	cert, err = tlsCfg.GetCertificate(nil)
+	assert.NoError(t, err)
+	leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "second", leaf.Subject.CommonName)
+
+	// Client CA
+	cfg.ClientCAFile = certFile
+	cfg.RequireClientCert = true
+	assert.NoError(t, r.Reload(cfg))
+
+	clientCfg, err = tlsCfg.GetConfigForClient(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, tls.RequireAndVerifyClientCert, clientCfg.ClientAuth)
+	assert.NotNil(t, clientCfg.ClientCAs)
+}
@@ -37,6 +37,7 @@ const GET_PARTITIONS = gql`
           opaConfiguration
           statusDataRetention
           decisionLogRetention
+          decisionLogOutcomeRule
         }
       }
     }
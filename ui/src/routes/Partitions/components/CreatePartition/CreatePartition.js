@@ -1,4 +1,4 @@
-import React from "react";
+import React, { useState } from "react";
 import Button from "@material-ui/core/Button";
 import Dialog from "@material-ui/core/Dialog";
 import DialogTitle from "@material-ui/core/DialogTitle";
@@ -18,17 +18,22 @@ const CREATE_PARTITION = gql`
     $name: String!
     $statusDataRetention: String
     $decisionLogRetention: String
+    $decisionLogOutcomeRule: String
   ) {
     createPartition(
       input: {
         name: $name
         statusDataRetention: $statusDataRetention
         decisionLogRetention: $decisionLogRetention
+        decisionLogOutcomeRule: $decisionLogOutcomeRule
       }
     ) {
       partition {
         id
       }
+      partitionToken {
+        value
+      }
     }
   }
 `;
@@ -47,8 +52,11 @@ function getNameDisplayErrorMessage(type) {
 }
 
 const durationRegex = /^\d+[smh]+(?:\d+[smh]+)*$/;
+const outcomeRuleRegex = /^!?(?:\/.*)?$/;
 
 function CreatePartition({ isOpened, handleClose, refetch }) {
+  // Default token value, displayed only once
+  const [token, setToken] = useState(null);
   // Form hook
   const { register, handleSubmit, errors: formErrors } = useForm();
   // Mutation hook
@@ -56,20 +64,55 @@ function CreatePartition({ isOpened, handleClose, refetch }) {
   // Callback for form answer
   const onSubmit = async (data) => {
     try {
-      await createPartition({
+      const res = await createPartition({
         variables: {
           name: data.name,
           statusDataRetention: data.statusDataRetention,
           decisionLogRetention: data.decisionLogRetention,
+          decisionLogOutcomeRule: data.decisionLogOutcomeRule,
         },
       });
       // Refetch data
       refetch();
-      // Close modal
-      handleClose();
+      // Display token as it cannot be retrieved later
+      setToken(res.data.createPartition.partitionToken.value);
     } catch (e) {}
   };
 
+  // Callback for token display close
+  const handleTokenClose = () => {
+    setToken(null);
+    handleClose();
+  };
+
+  if (token) {
+    return (
+      <Dialog
+        onClose={handleTokenClose}
+        aria-labelledby="dialog-title"
+        open={isOpened}
+      >
+        <DialogTitle id="dialog-title">Partition Created</DialogTitle>
+        <DialogContent dividers>
+          <Typography style={{ marginBottom: "5px" }}>
+            Here is the partition token. It will not be displayed again, set
+            it in the OPA_CENTER_TOKEN environment variable of your OPA agents.
+          </Typography>
+          <Typography
+            style={{ fontFamily: "monospace", wordBreak: "break-all" }}
+          >
+            {token}
+          </Typography>
+        </DialogContent>
+        <DialogActions>
+          <Button onClick={handleTokenClose} color="primary">
+            Close
+          </Button>
+        </DialogActions>
+      </Dialog>
+    );
+  }
+
   return (
     <Dialog
       onClose={handleClose}
@@ -154,6 +197,29 @@ function CreatePartition({ isOpened, handleClose, refetch }) {
               </FormHelperText>
             )}
           </FormControl>
+          <FormControl
+            error={!!formErrors.decisionLogOutcomeRule}
+            fullWidth
+            style={{ marginTop: "10px" }}
+          >
+            <InputLabel htmlFor="decisionLogOutcomeRule">
+              Decision Logs Outcome Rule
+            </InputLabel>
+            <Input
+              inputRef={register({
+                pattern: outcomeRuleRegex,
+              })}
+              id="decisionLogOutcomeRule"
+              fullWidth
+              label="Decision Logs Outcome Rule"
+              name="decisionLogOutcomeRule"
+            />
+            <FormHelperText>
+              {formErrors.decisionLogOutcomeRule
+                ? "Rule must be a JSON pointer starting with /, optionally prefixed by !."
+                : "JSON pointer into result targeting a boolean (empty means the whole result), prefixed by ! to classify true as denied. Example: /allow or !/deny."}
+            </FormHelperText>
+          </FormControl>
         </DialogContent>
         <DialogActions>
           <Button onClick={handleClose} disabled={loading} color="primary">
@@ -45,6 +45,10 @@ function PartitionCard({ partition, openEdit }) {
           Status data retention:{" "}
           <b>{partition.statusDataRetention || "Unlimited"}</b>
         </Typography>
+        <Typography variant="body2" component="p">
+          Decision logs outcome rule:{" "}
+          <b>{partition.decisionLogOutcomeRule || "Boolean result"}</b>
+        </Typography>
       </CardContent>
       <CardActions>
         <Button
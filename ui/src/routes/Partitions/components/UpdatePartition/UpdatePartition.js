@@ -18,12 +18,14 @@ const UPDATE_PARTITION = gql`
     $id: ID!
     $statusDataRetention: String
     $decisionLogRetention: String
+    $decisionLogOutcomeRule: String
   ) {
     updatePartition(
       input: {
         id: $id
         statusDataRetention: $statusDataRetention
         decisionLogRetention: $decisionLogRetention
+        decisionLogOutcomeRule: $decisionLogOutcomeRule
       }
     ) {
       partition {
@@ -34,12 +36,14 @@ const UPDATE_PARTITION = gql`
         opaConfiguration
         statusDataRetention
         decisionLogRetention
+        decisionLogOutcomeRule
       }
     }
   }
 `;
 
 const durationRegex = /^\d+[smh]+(?:\d+[smh]+)*$/;
+const outcomeRuleRegex = /^!?(?:\/.*)?$/;
 
 function UpdatePartition({ partition, isOpened, handleClose }) {
   // Form hook
@@ -47,6 +51,7 @@ function UpdatePartition({ partition, isOpened, handleClose }) {
     defaultValues: {
       statusDataRetention: partition.statusDataRetention,
       decisionLogRetention: partition.decisionLogRetention,
+      decisionLogOutcomeRule: partition.decisionLogOutcomeRule,
     },
   });
   // Mutation hook
@@ -59,6 +64,7 @@ function UpdatePartition({ partition, isOpened, handleClose }) {
           id: partition.id,
           statusDataRetention: data.statusDataRetention,
           decisionLogRetention: data.decisionLogRetention,
+          decisionLogOutcomeRule: data.decisionLogOutcomeRule,
         },
       });
       // Close modal
@@ -125,6 +131,29 @@ function UpdatePartition({ partition, isOpened, handleClose }) {
               </FormHelperText>
             )}
           </FormControl>
+          <FormControl
+            error={!!formErrors.decisionLogOutcomeRule}
+            fullWidth
+            style={{ marginTop: "10px" }}
+          >
+            <InputLabel htmlFor="decisionLogOutcomeRule">
+              Decision Logs Outcome Rule
+            </InputLabel>
+            <Input
+              inputRef={register({
+                pattern: outcomeRuleRegex,
+              })}
+              id="decisionLogOutcomeRule"
+              fullWidth
+              label="Decision Logs Outcome Rule"
+              name="decisionLogOutcomeRule"
+            />
+            <FormHelperText>
+              {formErrors.decisionLogOutcomeRule
+                ? "Rule must be a JSON pointer starting with /, optionally prefixed by !."
+                : "JSON pointer into result targeting a boolean (empty means the whole result), prefixed by ! to classify true as denied. Example: /allow or !/deny."}
+            </FormHelperText>
+          </FormControl>
         </DialogContent>
         <DialogActions>
           <Button onClick={handleClose} disabled={loading} color="primary">